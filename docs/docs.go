@@ -19,9 +19,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/audios": {
+        "/albums": {
             "get": {
-                "description": "List audio by Filter",
+                "description": "List albums ordered by release date",
                 "consumes": [
                     "application/json"
                 ],
@@ -29,46 +29,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Audio API"
+                    "Album API"
                 ],
-                "summary": "List audio by Filter",
+                "summary": "List albums",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "exact search",
-                        "name": "group",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "full-text-search (english)",
-                        "name": "song",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "after(include) search",
-                        "name": "after",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "before(include) search",
-                        "name": "before",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "exact search",
-                        "name": "link",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "full-text-search (english)",
-                        "name": "lyric",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "description": "rows limit",
@@ -86,13 +50,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseAudioRead"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseAlbumRead"
                         }
                     },
                     "500": {
@@ -104,7 +62,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create audio",
+                "description": "Create album. Artist is optional for compilations",
                 "consumes": [
                     "application/json"
                 ],
@@ -112,16 +70,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Audio API"
+                    "Album API"
                 ],
-                "summary": "Create audio",
+                "summary": "Create album",
                 "parameters": [
                     {
-                        "description": "Audio base",
-                        "name": "Audio",
+                        "description": "Album base",
+                        "name": "Album",
                         "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/schema.RequestAudioCreate"
+                            "$ref": "#/definitions/schema.RequestAlbumCreate"
                         }
                     }
                 ],
@@ -138,6 +96,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ResponseBaseErr"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -147,9 +111,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/audios/{uuid}": {
+        "/albums/{uuid}": {
             "get": {
-                "description": "Find by UUID",
+                "description": "Find album by UUID with tracks ordered by position",
                 "consumes": [
                     "application/json"
                 ],
@@ -157,28 +121,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Audio API"
+                    "Album API"
                 ],
-                "summary": "Find by UUID",
+                "summary": "Find album by UUID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Audio UUID",
-                        "name": "uuid",
-                        "in": "path"
+                        "description": "User ID, audios are marked with is_favorite",
+                        "name": "X-User-ID",
+                        "in": "header"
                     },
                     {
-                        "type": "boolean",
-                        "description": "With lyrics or not",
-                        "name": "full",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Album UUID",
+                        "name": "uuid",
+                        "in": "path"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioReadFull"
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAlbumReadFull"
                         }
                     },
                     "400": {
@@ -196,7 +160,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete audio by UUID",
+                "description": "Delete album by UUID. Album audios are kept",
                 "consumes": [
                     "application/json"
                 ],
@@ -204,13 +168,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Audio API"
+                    "Album API"
                 ],
-                "summary": "Delete audio by UUID",
+                "summary": "Delete album by UUID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Audio UUID",
+                        "description": "Album UUID",
                         "name": "uuid",
                         "in": "path"
                     }
@@ -237,7 +201,7 @@ const docTemplate = `{
                 }
             },
             "patch": {
-                "description": "Update audio by UUID",
+                "description": "Update album by UUID",
                 "consumes": [
                     "application/json"
                 ],
@@ -245,22 +209,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Audio API"
+                    "Album API"
                 ],
-                "summary": "Update audio by UUID",
+                "summary": "Update album by UUID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Audio UUID",
+                        "description": "Album UUID",
                         "name": "uuid",
                         "in": "path"
                     },
                     {
-                        "description": "Audio update base",
-                        "name": "Audio",
+                        "description": "Album update base",
+                        "name": "Album",
                         "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/schema.RequestAudioUpdate"
+                            "$ref": "#/definitions/schema.RequestAlbumUpdate"
                         }
                     }
                 ],
@@ -268,7 +232,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioRead"
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAlbumRead"
                         }
                     },
                     "400": {
@@ -277,6 +241,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ResponseBaseErr"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -286,44 +256,95 @@ const docTemplate = `{
                 }
             }
         },
-        "/audios/{uuid}/lyrics": {
-            "get": {
-                "description": "List audio lyrics by UUID",
+        "/albums/{uuid}/cover": {
+            "put": {
+                "description": "Upload jpeg or png image as raw body. Previous cover is replaced.\nThumbnails of 64, 256 and 640 px are generated",
                 "consumes": [
-                    "application/json"
+                    "image/jpeg",
+                    "image/png"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Audio API"
+                    "Cover API"
                 ],
-                "summary": "List audio lyrics by UUID",
+                "summary": "Upload album cover",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Audio UUID",
+                        "description": "Album UUID",
                         "name": "uuid",
                         "in": "path"
                     },
                     {
-                        "type": "string",
-                        "description": "rows limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Image",
+                        "name": "Image",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseCover"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete album cover with its thumbnails",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cover API"
+                ],
+                "summary": "Delete album cover",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "rows offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Album UUID",
+                        "name": "uuid",
+                        "in": "path"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseLyricRead"
+                            "$ref": "#/definitions/v1.ResponseBase-string"
                         }
                     },
                     "400": {
@@ -340,219 +361,5355 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "crud.Pagination": {
-            "type": "object",
-            "properties": {
-                "limit": {
-                    "type": "integer"
-                },
-                "offset": {
-                    "type": "integer"
-                }
-            }
         },
-        "schema.RequestAudioCreate": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "type": "string",
-                    "example": "classic"
-                },
-                "song": {
-                    "type": "string",
-                    "example": "some song"
+        "/albums/{uuid}/tracks": {
+            "put": {
+                "description": "Set album tracks to audios in given order. Used to reorder tracks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Album API"
+                ],
+                "summary": "Replace album tracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Album UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Ordered audio UUIDs",
+                        "name": "Tracks",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestAlbumTracksReplace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAlbumReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add audio to album at position, following tracks are shifted. Omitted position appends track to the end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Album API"
+                ],
+                "summary": "Add track to album",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Album UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Track",
+                        "name": "Track",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestAlbumTrackAdd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAlbumReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
                 }
             }
         },
-        "schema.RequestAudioUpdate": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "type": "string",
+        "/albums/{uuid}/tracks/{audio_uuid}": {
+            "delete": {
+                "description": "Remove audio from album, following tracks are shifted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Album API"
+                ],
+                "summary": "Remove track from album",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Album UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "audio_uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAlbumReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists": {
+            "get": {
+                "description": "List artists ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "List artists",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseArtistRead"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create artist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Create artist",
+                "parameters": [
+                    {
+                        "description": "Artist base",
+                        "name": "Artist",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestArtistCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{uuid}": {
+            "get": {
+                "description": "Find artist by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Find artist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseArtistRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete artist by UUID. Artist with audios cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Delete artist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update artist by UUID. Rename updates \"group\" of all artist audios",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Update artist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Artist update base",
+                        "name": "Artist",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestArtistUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseArtistRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{uuid}/aliases": {
+            "get": {
+                "description": "List artist aliases by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "List artist aliases by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-array_schema_ResponseArtistAliasRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create artist alias. Alias is matched after name canonicalization and must be unique across artists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Create artist alias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Alias base",
+                        "name": "Alias",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestArtistAliasCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{uuid}/aliases/{alias_uuid}": {
+            "delete": {
+                "description": "Delete artist alias by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Delete artist alias by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias UUID",
+                        "name": "alias_uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{uuid}/audios": {
+            "get": {
+                "description": "List artist audios by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "List artist audios by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, audios are marked with is_favorite",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseAudioRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{uuid}/merge": {
+            "post": {
+                "description": "Merge source artist into artist by UUID. Source audios, albums and aliases are moved, source name is kept as alias and source is deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Artist API"
+                ],
+                "summary": "Merge artist into artist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target artist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Source artist",
+                        "name": "Merge",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestArtistMerge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseArtistRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios": {
+            "get": {
+                "description": "List audio by Filter. Accept audio/x-mpegurl returns all matched audios as extended M3U playlist,\napplication/xspf+xml as XSPF playlist, limit and offset are ignored. Audios without link are left out of M3U.\nPlaylist is cut after 10000 audios with X-Export-Truncated: true header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "audio/x-mpegurl",
+                    "application/xspf+xml"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "List audio by Filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, audios are marked with is_favorite",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "exact search",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full-text-search (english)",
+                        "name": "song",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "after(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "before(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "any link of audio, matched in canonical form",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full-text-search (english)",
+                        "name": "lyric",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "credited person, matched by canonical name",
+                        "name": "credit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "performer",
+                            "featured",
+                            "writer",
+                            "composer",
+                            "lyricist",
+                            "producer"
+                        ],
+                        "type": "string",
+                        "description": "credit role, requires credit",
+                        "name": "credit_role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "genre slug, subgenres match too",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "match any or all genres",
+                        "name": "genre_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "match any or all tags",
+                        "name": "tag_mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "youtube",
+                            "spotify",
+                            "apple",
+                            "yandex",
+                            "soundcloud",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "has link on platform",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "min duration (include), milliseconds",
+                        "name": "duration_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max duration (include), milliseconds",
+                        "name": "duration_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "exact search, hyphens allowed",
+                        "name": "isrc",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "explicit content or not",
+                        "name": "explicit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "play_count",
+                            "-play_count",
+                            "created_at",
+                            "-created_at",
+                            "release_date",
+                            "-release_date"
+                        ],
+                        "type": "string",
+                        "description": "sort key, '-' prefix sorts descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseAudioRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create audio. Omitted release_date, link and lyrics are fetched from the info service.\nWith source \"manual\" the info service is not called and release_date and link are required.\nBody with audio/* content type is mp3, flac or ogg file: audio is created from its tags\nwith manual source, file is attached and response data is schema.ResponseAudioFileUpload",
+                "consumes": [
+                    "application/json",
+                    "audio/mpeg",
+                    "audio/flac",
+                    "audio/ogg"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "Create audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Audio base",
+                        "name": "Audio",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestAudioCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}": {
+            "get": {
+                "description": "Find by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "Find by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With lyrics, album, credits, genres, tags and links or not",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete audio by UUID. Audio is removed from playlists, following entries are shifted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "Delete audio by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update audio by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "Update audio by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Audio update base",
+                        "name": "Audio",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestAudioUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/analysis": {
+            "get": {
+                "description": "Count verses, lines and words of audio lyrics. Top words are most frequent words\nwithout english and russian stop words, repetition_score is share of lines repeating\nan earlier line, e.g. chorus",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "Analyze audio lyrics by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseLyricAnalysis"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/cover": {
+            "put": {
+                "description": "Upload jpeg or png image as raw body. Previous cover is replaced.\nThumbnails of 64, 256 and 640 px are generated",
+                "consumes": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cover API"
+                ],
+                "summary": "Upload audio cover",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Image",
+                        "name": "Image",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseCover"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete audio cover with its thumbnails",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cover API"
+                ],
+                "summary": "Delete audio cover",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/credits": {
+            "get": {
+                "description": "List performers, writers, composers and producers credited on audio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Credit API"
+                ],
+                "summary": "List audio credits by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-array_schema_ResponseCreditRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Credit person on audio in role. Person is matched by canonical name and created if not exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Credit API"
+                ],
+                "summary": "Credit person on audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Credit base",
+                        "name": "Credit",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestCreditCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/credits/{credit_uuid}": {
+            "delete": {
+                "description": "Delete audio credit by UUID, person is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Credit API"
+                ],
+                "summary": "Delete audio credit by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Credit UUID",
+                        "name": "credit_uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/favorite": {
+            "put": {
+                "description": "Add audio to favorites of user from X-User-ID header. Liking favorite audio again does nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorite API"
+                ],
+                "summary": "Like audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove audio from favorites of user from X-User-ID header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorite API"
+                ],
+                "summary": "Unlike audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/file": {
+            "put": {
+                "description": "Upload mp3, flac or ogg file of audio as raw body, previous file is replaced.\nTags of file are returned with audio fields they suggest: fields missing\nor different in audio. Suggested fields are not applied",
+                "consumes": [
+                    "audio/mpeg",
+                    "audio/flac",
+                    "audio/ogg"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "File API"
+                ],
+                "summary": "Upload audio file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Audio file",
+                        "name": "File",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioFileUpload"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/genres": {
+            "post": {
+                "description": "Attach genre to audio, attaching twice is not an error",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre API"
+                ],
+                "summary": "Attach genre to audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Genre",
+                        "name": "Genre",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestAudioGenreAttach"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/genres/{genre_uuid}": {
+            "delete": {
+                "description": "Detach genre from audio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre API"
+                ],
+                "summary": "Detach genre from audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Genre UUID",
+                        "name": "genre_uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/links": {
+            "get": {
+                "description": "List audio links on streaming platforms, primary link first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Link API"
+                ],
+                "summary": "List audio links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-array_schema_ResponseLinkRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add link on streaming platform to audio. Platform is detected from url if omitted.\nFirst link of audio becomes primary, primary link is copied to audio link field",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Link API"
+                ],
+                "summary": "Add audio link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Link",
+                        "name": "Link",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestLinkCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/links/{link_uuid}": {
+            "delete": {
+                "description": "Delete audio link. If primary link is deleted the oldest remaining link becomes primary",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Link API"
+                ],
+                "summary": "Delete audio link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link UUID",
+                        "name": "link_uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/links/{link_uuid}/primary": {
+            "post": {
+                "description": "Make link primary, audio link field is updated to its url",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Link API"
+                ],
+                "summary": "Set primary audio link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link UUID",
+                        "name": "link_uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Editor id",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/lyrics": {
+            "get": {
+                "description": "List audio lyrics by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "List audio lyrics by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseLyricRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/plays": {
+            "post": {
+                "description": "Record listen of audio by user from X-User-ID header and count it in audio play_count.\nBody may be omitted, played_at defaults to now and cannot be older than 24 hours. Plays of the same audio by user\nwithin dedup window of played_at or of the time play was recorded are recorded once: already recorded play is returned with 200",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Play API"
+                ],
+                "summary": "Record play",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Play",
+                        "name": "Play",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestPlayCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlayRead"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlayRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/provenance": {
+            "get": {
+                "description": "List source, fetch time and editor of each audio field",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "List audio fields provenance by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-array_schema_ResponseProvenanceRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/refresh": {
+            "post": {
+                "description": "Fetch audio info again and update fields not edited manually",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audio API"
+                ],
+                "summary": "Refresh audio from info service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/stream": {
+            "get": {
+                "description": "Return uploaded audio file. Range requests are answered with 206 Partial Content,\nETag is sha256 of file, If-None-Match, If-Modified-Since and If-Range are supported",
+                "produces": [
+                    "audio/mpeg",
+                    "audio/flac",
+                    "audio/ogg"
+                ],
+                "tags": [
+                    "File API"
+                ],
+                "summary": "Stream audio file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Byte range, example: bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Partial Content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/tags": {
+            "post": {
+                "description": "Attach free-form tag to audio. Tag is stored lower case and created if not exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag API"
+                ],
+                "summary": "Tag audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Tag",
+                        "name": "Tag",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestAudioTagAttach"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/audios/{uuid}/tags/{tag}": {
+            "delete": {
+                "description": "Detach tag from audio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag API"
+                ],
+                "summary": "Untag audio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Audio UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/charts": {
+            "get": {
+                "description": "List most played audios or groups for period, top positions first. Previous position is\nof the preceding period of the same length, for all time it is as of a week ago.\nCharts are refreshed periodically, refreshed_at is time of last refresh",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chart API"
+                ],
+                "summary": "List chart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, audios are marked with is_favorite",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "month",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "chart period, default week",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "audio",
+                            "group"
+                        ],
+                        "type": "string",
+                        "description": "chart audios or groups, default audio",
+                        "name": "by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseChartEntryRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres": {
+            "get": {
+                "description": "List genres ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre API"
+                ],
+                "summary": "List genres",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseGenreRead"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create genre, optionally as subgenre of parent. Slug is derived from name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre API"
+                ],
+                "summary": "Create genre",
+                "parameters": [
+                    {
+                        "description": "Genre base",
+                        "name": "Genre",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestGenreCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{uuid}": {
+            "get": {
+                "description": "Find genre by UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre API"
+                ],
+                "summary": "Find genre by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Genre UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseGenreRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete genre by UUID. Genre with subgenres cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre API"
+                ],
+                "summary": "Delete genre by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Genre UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/favorites": {
+            "get": {
+                "description": "List favorite audios of user from X-User-ID header, recently liked first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorite API"
+                ],
+                "summary": "List favorites",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseFavoriteRead"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/history": {
+            "get": {
+                "description": "List audios played by user from X-User-ID header, recent plays first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Play API"
+                ],
+                "summary": "Listening history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponsePlayHistoryRead"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists": {
+            "get": {
+                "description": "List playlists of owner ordered by last update. Owner defaults to user from\nX-User-ID header, all playlists are listed if neither is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "List playlists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "owner user id",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponsePlaylistRead"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create playlist owned by user from X-User-ID header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Create playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Playlist base",
+                        "name": "Playlist",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestPlaylistCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{uuid}": {
+            "get": {
+                "description": "Find playlist by UUID with audios ordered by position. Accept audio/x-mpegurl returns\nextended M3U playlist, application/xspf+xml XSPF playlist. Audios without link are left out of M3U",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "audio/x-mpegurl",
+                    "application/xspf+xml"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Find playlist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, audios are marked with is_favorite",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlaylistReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete playlist by UUID, allowed to owner only. Playlist audios are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Delete playlist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseUUID"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Rename playlist or change its description, allowed to owner only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Update playlist by UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Playlist update base",
+                        "name": "Playlist",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestPlaylistUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlaylistRead"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{uuid}/entries": {
+            "post": {
+                "description": "Add audio to playlist at position, following entries are shifted. Omitted position\nappends audio to the end. The same audio may be added several times",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Add audio to playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "Entry",
+                        "name": "Entry",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestPlaylistEntryAdd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlaylistReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{uuid}/entries/{entry_uuid}": {
+            "delete": {
+                "description": "Remove entry from playlist, following entries are shifted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Remove entry from playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entry UUID",
+                        "name": "entry_uuid",
+                        "in": "path"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlaylistReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{uuid}/entries/{entry_uuid}/move": {
+            "post": {
+                "description": "Move entry to position, entries between old and new positions are shifted.\nZero or out of range position moves entry to the end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist API"
+                ],
+                "summary": "Move playlist entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist UUID",
+                        "name": "uuid",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entry UUID",
+                        "name": "entry_uuid",
+                        "in": "path"
+                    },
+                    {
+                        "description": "New position",
+                        "name": "Position",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestPlaylistEntryMove"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponsePlaylistReadFull"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats": {
+            "get": {
+                "description": "Count audios per group and release year, audios without lyrics, link or release date,\nand average verses per audio with lyrics. Audios are filtered like in audio list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats API"
+                ],
+                "summary": "Catalog statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "exact search",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full-text-search (english)",
+                        "name": "song",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "after(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "before(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "any link of audio, matched in canonical form",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "full-text-search (english)",
+                        "name": "lyric",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "credited person, matched by canonical name",
+                        "name": "credit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "performer",
+                            "featured",
+                            "writer",
+                            "composer",
+                            "lyricist",
+                            "producer"
+                        ],
+                        "type": "string",
+                        "description": "credit role, requires credit",
+                        "name": "credit_role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "genre slug, subgenres match too",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "match any or all genres",
+                        "name": "genre_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "match any or all tags",
+                        "name": "tag_mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "youtube",
+                            "spotify",
+                            "apple",
+                            "yandex",
+                            "soundcloud",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "has link on platform",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "min duration (include), milliseconds",
+                        "name": "duration_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max duration (include), milliseconds",
+                        "name": "duration_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "exact search, hyphens allowed",
+                        "name": "isrc",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "explicit content or not",
+                        "name": "explicit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBase-schema_ResponseAudioStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "List tags with number of tagged audios, most used first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag API"
+                ],
+                "summary": "List tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "rows limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "rows offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBasePaginated-schema_ResponseTagRead"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResponseBaseErr"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "crud.Pagination": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.RequestAlbumCreate": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "1969-09-26"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Abbey Road"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ],
+                    "example": "album"
+                }
+            }
+        },
+        "schema.RequestAlbumTrackAdd": {
+            "type": "object",
+            "properties": {
+                "audio_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "schema.RequestAlbumTracksReplace": {
+            "type": "object",
+            "properties": {
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                    ]
+                }
+            }
+        },
+        "schema.RequestAlbumUpdate": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "1969-09-26"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Abbey Road"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ],
+                    "example": "album"
+                }
+            }
+        },
+        "schema.RequestArtistAliasCreate": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "example": "Beatles"
+                }
+            }
+        },
+        "schema.RequestArtistCreate": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "GB"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "English rock band formed in Liverpool in 1960"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "The Beatles"
+                }
+            }
+        },
+        "schema.RequestArtistMerge": {
+            "type": "object",
+            "properties": {
+                "source_uuid": {
+                    "type": "string",
+                    "example": "5e0a1c0e-2f4b-4a43-8d3f-0f4b7c2d9a10"
+                }
+            }
+        },
+        "schema.RequestArtistUpdate": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "GB"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "English rock band formed in Liverpool in 1960"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "The Beatles"
+                }
+            }
+        },
+        "schema.RequestAudioCreate": {
+            "type": "object",
+            "properties": {
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GB-AYE-87-00123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "lyrics": {
+                    "type": "string",
+                    "example": "Never gonna give you up\n\nnever gonna let you down"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "info",
+                        "manual"
+                    ],
+                    "example": "manual"
+                }
+            }
+        },
+        "schema.RequestAudioGenreAttach": {
+            "type": "object",
+            "properties": {
+                "genre_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.RequestAudioTagAttach": {
+            "type": "object",
+            "properties": {
+                "tag": {
+                    "type": "string",
+                    "example": "summer hits"
+                }
+            }
+        },
+        "schema.RequestAudioUpdate": {
+            "type": "object",
+            "properties": {
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GB-AYE-87-00123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "lyrics": {
+                    "type": "string",
+                    "example": "Never gonna give you up\n\nnever gonna let you down"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                }
+            }
+        },
+        "schema.RequestCreditCreate": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Paul McCartney"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "performer",
+                        "featured",
+                        "writer",
+                        "composer",
+                        "lyricist",
+                        "producer"
+                    ],
+                    "example": "writer"
+                }
+            }
+        },
+        "schema.RequestGenreCreate": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Post-Punk"
+                },
+                "parent_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.RequestLinkCreate": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "youtube",
+                        "spotify",
+                        "apple",
+                        "yandex",
+                        "soundcloud",
+                        "other"
+                    ],
+                    "example": "spotify"
+                },
+                "primary": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC"
+                }
+            }
+        },
+        "schema.RequestPlayCreate": {
+            "type": "object",
+            "properties": {
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 180000
+                },
+                "played_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19+05:00"
+                }
+            }
+        },
+        "schema.RequestPlaylistCreate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Songs for long drives"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Road trip"
+                }
+            }
+        },
+        "schema.RequestPlaylistEntryAdd": {
+            "type": "object",
+            "properties": {
+                "audio_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "schema.RequestPlaylistEntryMove": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "schema.RequestPlaylistUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Songs for long drives"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Road trip"
+                }
+            }
+        },
+        "schema.ResponseAlbumRead": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "1969-09-26"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Abbey Road"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "album"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseAlbumReadFull": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "1969-09-26"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Abbey Road"
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseAlbumTrackRead"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "album"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseAlbumTrackRead": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GBAYE8700123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "play_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseArtistAliasRead": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "example": "Beatles"
+                },
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "normalized": {
+                    "type": "string",
+                    "example": "beatles"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "1b0c6a3e-7f0a-4a39-9a57-8b3a0d3e7c11"
+                }
+            }
+        },
+        "schema.ResponseArtistRead": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "GB"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "English rock band formed in Liverpool in 1960"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "The Beatles"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseAudioAlbum": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "1969-09-26"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Abbey Road"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "album"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseAudioFile": {
+            "type": "object",
+            "properties": {
+                "audio_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "format": {
+                    "type": "string",
+                    "enum": [
+                        "mp3",
+                        "flac",
+                        "ogg"
+                    ],
+                    "example": "mp3"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 5242880
+                }
+            }
+        },
+        "schema.ResponseAudioFileUpload": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "$ref": "#/definitions/schema.ResponseAudioFile"
+                },
+                "suggested": {
+                    "$ref": "#/definitions/schema.ResponseAudioSuggestion"
+                },
+                "tags": {
+                    "$ref": "#/definitions/schema.ResponseAudioTags"
+                }
+            }
+        },
+        "schema.ResponseAudioRead": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GBAYE8700123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "play_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseAudioReadFull": {
+            "type": "object",
+            "properties": {
+                "album": {
+                    "$ref": "#/definitions/schema.ResponseAudioAlbum"
+                },
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseCreditRead"
+                    }
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "explicit_verses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                    ]
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseGenreRead"
+                    }
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GBAYE8700123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseLinkRead"
+                    }
+                },
+                "lyrics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseLyricRead"
+                    }
+                },
+                "play_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseAudioStats": {
+            "type": "object",
+            "properties": {
+                "audios": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "avg_verses": {
+                    "type": "number",
+                    "example": 4.25
+                },
+                "by_group": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseGroupAudioStat"
+                    }
+                },
+                "by_year": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseYearAudioStat"
+                    }
+                },
+                "without_link": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "without_lyrics": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "without_release_date": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "schema.ResponseAudioSuggestion": {
+            "type": "object",
+            "properties": {
+                "album": {
+                    "type": "string",
+                    "example": "Whenever You Need Somebody"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "group": {
+                    "type": "string",
+                    "example": "Rick Astley"
+                },
+                "lyrics": {
+                    "type": "string",
+                    "example": "Never gonna give you up"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "1987"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "Never Gonna Give You Up"
+                },
+                "track_number": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "schema.ResponseAudioTags": {
+            "type": "object",
+            "properties": {
+                "album": {
+                    "type": "string",
+                    "example": "Whenever You Need Somebody"
+                },
+                "artist": {
+                    "type": "string",
+                    "example": "Rick Astley"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "lyrics": {
+                    "type": "string",
+                    "example": "Never gonna give you up"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Never Gonna Give You Up"
+                },
+                "track_number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 1987
+                }
+            }
+        },
+        "schema.ResponseChartEntryRead": {
+            "type": "object",
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/schema.ResponseArtistRead"
+                },
+                "audio": {
+                    "$ref": "#/definitions/schema.ResponseAudioRead"
+                },
+                "movement": {
+                    "type": "string",
+                    "enum": [
+                        "up",
+                        "down",
+                        "same",
+                        "new"
+                    ],
+                    "example": "up"
+                },
+                "plays": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "previous_position": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "refreshed_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                }
+            }
+        },
+        "schema.ResponseCover": {
+            "type": "object",
+            "properties": {
+                "original": {
+                    "type": "string",
+                    "example": "/blob/covers/audios/da6f6e2cef5d4276b0a15067e77278ca/m1x2k3/original.jpg"
+                },
+                "thumbnails": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "256": "/blob/covers/audios/da6f6e2cef5d4276b0a15067e77278ca/m1x2k3/256.jpg"
+                    }
+                }
+            }
+        },
+        "schema.ResponseCreditRead": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Paul McCartney"
+                },
+                "person_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "writer"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseFavoriteRead": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "favorited_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GBAYE8700123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "play_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseGenreRead": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Post-Punk"
+                },
+                "parent_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "post-punk"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseGroupAudioStat": {
+            "type": "object",
+            "properties": {
+                "audios": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "group": {
+                    "type": "string",
+                    "example": "The Beatles"
+                }
+            }
+        },
+        "schema.ResponseLinkRead": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "external_id": {
+                    "type": "string",
+                    "example": "dQw4w9WgXcQ"
+                },
+                "is_primary": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "platform": {
+                    "type": "string",
+                    "example": "youtube"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseLyricAnalysis": {
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "ru"
+                    ],
+                    "example": "en"
+                },
+                "lines": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "repetition_score": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "top_words": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseWordCount"
+                    }
+                },
+                "unique_ratio": {
+                    "type": "number",
+                    "example": 0.35
+                },
+                "unique_words": {
+                    "type": "integer",
+                    "example": 63
+                },
+                "verses": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "words": {
+                    "type": "integer",
+                    "example": 180
+                }
+            }
+        },
+        "schema.ResponseLyricRead": {
+            "type": "object",
+            "properties": {
+                "audio_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Never gonna give you up"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponsePlayHistoryRead": {
+            "type": "object",
+            "properties": {
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "group": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GBAYE8700123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
+                "link": {
+                    "type": "string",
+                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                },
+                "play_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "play_duration_ms": {
+                    "type": "integer",
+                    "example": 180000
+                },
+                "play_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "played_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponsePlayRead": {
+            "type": "object",
+            "properties": {
+                "audio_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 180000
+                },
+                "played_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponsePlaylistEntryRead": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "artist_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "bpm": {
+                    "type": "number",
+                    "example": 113
+                },
+                "cover": {
+                    "$ref": "#/definitions/schema.ResponseCover"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 213000
+                },
+                "entry_uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                },
+                "explicit": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "group": {
+                    "type": "string",
                     "example": "classic"
                 },
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "GBAYE8700123"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "Ab major"
+                },
                 "link": {
                     "type": "string",
                     "example": "https://youtu.be/dQw4w9WgXcQ"
                 },
-                "lyrics": {
-                    "type": "string",
-                    "example": "Never gonna give you up\n\nnever gonna let you down"
+                "play_count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2012-09-23"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "some song"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponsePlaylistRead": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Songs for long drives"
+                },
+                "entry_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Road trip"
+                },
+                "owner": {
+                    "type": "string",
+                    "example": "user-42"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponsePlaylistReadFull": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Songs for long drives"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponsePlaylistEntryRead"
+                    }
+                },
+                "entry_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Road trip"
+                },
+                "owner": {
+                    "type": "string",
+                    "example": "user-42"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseProvenanceRead": {
+            "type": "object",
+            "properties": {
+                "editor": {
+                    "type": "string",
+                    "example": "editor@example.com"
+                },
+                "fetched_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "release_date"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "info"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                }
+            }
+        },
+        "schema.ResponseTagRead": {
+            "type": "object",
+            "properties": {
+                "audio_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-10-05T12:57:19.752+05:00"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "summer hits"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseUUID": {
+            "type": "object",
+            "properties": {
+                "uuid": {
+                    "type": "string",
+                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                }
+            }
+        },
+        "schema.ResponseWordCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "word": {
+                    "type": "string",
+                    "example": "never"
+                }
+            }
+        },
+        "schema.ResponseYearAudioStat": {
+            "type": "object",
+            "properties": {
+                "audios": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2006
+                }
+            }
+        },
+        "v1.ResponseBase-array_schema_ResponseArtistAliasRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseArtistAliasRead"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-array_schema_ResponseCreditRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseCreditRead"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-array_schema_ResponseLinkRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseLinkRead"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-array_schema_ResponseProvenanceRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseProvenanceRead"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseAlbumRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseAlbumRead"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseAlbumReadFull": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseAlbumReadFull"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseArtistRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseArtistRead"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseAudioFileUpload": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseAudioFileUpload"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseAudioRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseAudioRead"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseAudioReadFull": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseAudioReadFull"
                 },
-                "release_date": {
-                    "type": "string",
-                    "example": "2012-09-23"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseAudioStats": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseAudioStats"
                 },
-                "song": {
-                    "type": "string",
-                    "example": "some song"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "schema.ResponseAudioRead": {
+        "v1.ResponseBase-schema_ResponseCover": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-10-05T12:57:19.752+05:00"
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseCover"
                 },
-                "group": {
-                    "type": "string",
-                    "example": "classic"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseGenreRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseGenreRead"
                 },
-                "link": {
-                    "type": "string",
-                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponseLyricAnalysis": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseLyricAnalysis"
                 },
-                "release_date": {
-                    "type": "string",
-                    "example": "2012-09-23"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponsePlayRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponsePlayRead"
                 },
-                "song": {
-                    "type": "string",
-                    "example": "some song"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponsePlaylistRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponsePlaylistRead"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2024-10-05T12:57:19.752+05:00"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-schema_ResponsePlaylistReadFull": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/schema.ResponsePlaylistReadFull"
                 },
-                "uuid": {
-                    "type": "string",
-                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "schema.ResponseAudioReadFull": {
+        "v1.ResponseBase-schema_ResponseUUID": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-10-05T12:57:19.752+05:00"
+                "data": {
+                    "$ref": "#/definitions/schema.ResponseUUID"
                 },
-                "group": {
-                    "type": "string",
-                    "example": "classic"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBase-string": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "string"
                 },
-                "link": {
-                    "type": "string",
-                    "example": "https://youtu.be/dQw4w9WgXcQ"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBaseErr": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
                 },
-                "lyrics": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ResponseBasePaginated-schema_ResponseAlbumRead": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.ResponseLyricRead"
+                        "$ref": "#/definitions/schema.ResponseAlbumRead"
                     }
                 },
-                "release_date": {
-                    "type": "string",
-                    "example": "2012-09-23"
-                },
-                "song": {
-                    "type": "string",
-                    "example": "some song"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2024-10-05T12:57:19.752+05:00"
+                "message": {
+                    "type": "string"
                 },
-                "uuid": {
-                    "type": "string",
-                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "schema.ResponseLyricRead": {
+        "v1.ResponseBasePaginated-schema_ResponseArtistRead": {
             "type": "object",
             "properties": {
-                "audio_uuid": {
-                    "type": "string",
-                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-10-05T12:57:19.752+05:00"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseArtistRead"
+                    }
                 },
-                "order": {
-                    "type": "integer",
-                    "example": 0
+                "message": {
+                    "type": "string"
                 },
-                "text": {
-                    "type": "string",
-                    "example": "Never gonna give you up"
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
+                }
+            }
+        },
+        "v1.ResponseBasePaginated-schema_ResponseAudioRead": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseAudioRead"
+                    }
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2024-10-05T12:57:19.752+05:00"
+                "message": {
+                    "type": "string"
                 },
-                "uuid": {
-                    "type": "string",
-                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "schema.ResponseUUID": {
+        "v1.ResponseBasePaginated-schema_ResponseChartEntryRead": {
             "type": "object",
             "properties": {
-                "uuid": {
-                    "type": "string",
-                    "example": "da6f6e2c-ef5d-4276-b0a1-5067e77278ca"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseChartEntryRead"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "v1.ResponseBase-schema_ResponseAudioRead": {
+        "v1.ResponseBasePaginated-schema_ResponseFavoriteRead": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/schema.ResponseAudioRead"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseFavoriteRead"
+                    }
                 },
                 "message": {
                     "type": "string"
+                },
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "v1.ResponseBase-schema_ResponseAudioReadFull": {
+        "v1.ResponseBasePaginated-schema_ResponseGenreRead": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/schema.ResponseAudioReadFull"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseGenreRead"
+                    }
                 },
                 "message": {
                     "type": "string"
+                },
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "v1.ResponseBase-schema_ResponseUUID": {
+        "v1.ResponseBasePaginated-schema_ResponseLyricRead": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/schema.ResponseUUID"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponseLyricRead"
+                    }
                 },
                 "message": {
                     "type": "string"
+                },
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "v1.ResponseBaseErr": {
+        "v1.ResponseBasePaginated-schema_ResponsePlayHistoryRead": {
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ResponsePlayHistoryRead"
+                    }
                 },
                 "message": {
                     "type": "string"
+                },
+                "next_pagination": {
+                    "$ref": "#/definitions/crud.Pagination"
                 }
             }
         },
-        "v1.ResponseBasePaginated-schema_ResponseAudioRead": {
+        "v1.ResponseBasePaginated-schema_ResponsePlaylistRead": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.ResponseAudioRead"
+                        "$ref": "#/definitions/schema.ResponsePlaylistRead"
                     }
                 },
                 "message": {
@@ -563,13 +5720,13 @@ const docTemplate = `{
                 }
             }
         },
-        "v1.ResponseBasePaginated-schema_ResponseLyricRead": {
+        "v1.ResponseBasePaginated-schema_ResponseTagRead": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.ResponseLyricRead"
+                        "$ref": "#/definitions/schema.ResponseTagRead"
                     }
                 },
                 "message": {
@@ -5,11 +5,16 @@ import (
 	"eMobile/docs"
 	"eMobile/internal/config"
 	"eMobile/internal/crud"
+	"eMobile/internal/dto"
 	"eMobile/internal/repo"
 	"eMobile/internal/route"
+	"eMobile/internal/schema"
 	"eMobile/internal/service"
+	"eMobile/internal/service/audioService"
+	"eMobile/pkg/blob"
 	"eMobile/pkg/logging"
 	"eMobile/pkg/migrator"
+	"eMobile/pkg/profanity"
 	"errors"
 	"fmt"
 	"github.com/golang-migrate/migrate/v4"
@@ -18,6 +23,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -50,14 +56,120 @@ func Run() {
 		log.Fatal("Error on run migration: ", err)
 	}
 
+	// init info service cache
+	infoCacheTTL := audioService.InfoCacheTTL{
+		Found:    conf.InfoCache.TTL,
+		NotFound: conf.InfoCache.NotFoundTTL,
+	}
+	var infoCache audioService.InfoCache
+	switch conf.InfoCache.Type {
+	case "memory":
+		infoCache = audioService.NewMemoryInfoCache(conf.InfoCache.Size, infoCacheTTL)
+	case "postgres":
+		infoCache = audioService.NewRepoInfoCache(repositories.InfoCache, infoCacheTTL)
+	case "none":
+	default:
+		log.Fatal("Unknown info cache type: ", conf.InfoCache.Type)
+	}
+	log.Infof("Info cache: %s", conf.InfoCache.Type)
+
+	// init blob store
+	var blobStore blob.Store
+	var blobHandler http.Handler
+	switch conf.Blob.Type {
+	case "local":
+		local, err := blob.NewLocal(conf.Blob.Dir, conf.Blob.URL)
+		if err != nil {
+			log.Fatal("Error initializing blob store: ", err)
+		}
+		blobStore = local
+		// audio files are streamed by API only, so only covers are public
+		blobHandler = blob.Handler(local, dto.CoverKeyPrefix)
+	default:
+		log.Fatal("Unknown blob store type: ", conf.Blob.Type)
+	}
+	schema.SetBlobURL(blobStore.URL)
+	log.Infof("Blob store: %s", conf.Blob.Type)
+
+	// init explicit lyrics classifier
+	var classifier audioService.Classifier
+	if len(conf.Profanity.Languages) > 0 && conf.Profanity.Languages[0] != "none" {
+		profanityClassifier, err := profanity.New(conf.Profanity.Languages, conf.Profanity.Dir)
+		if err != nil {
+			log.Fatal("Error initializing profanity classifier: ", err)
+		}
+		classifier = profanityClassifier
+	}
+	log.Infof("Profanity languages: %s", strings.Join(conf.Profanity.Languages, ","))
+
 	// init services
 	services := service.NewService(&service.Deps{
-		Repo:       repositories,
-		Logger:     log,
-		HttpClient: http.DefaultClient,
-		InfoURL:    conf.Server.InfoServiceUrl,
+		Repo:            repositories,
+		Logger:          log,
+		HttpClient:      http.DefaultClient,
+		InfoURL:         conf.Server.InfoServiceUrl,
+		InfoCache:       infoCache,
+		Classifier:      classifier,
+		Blob:            blobStore,
+		PlayDedupWindow: conf.Plays.DedupWindow,
 	})
 
+	// fill canonical artist names missing after migrations
+	err = services.Artist.NormalizeNames()
+	if err != nil {
+		log.Fatal("Error on normalize artist names: ", err)
+	}
+
+	// create missing audio links and canonicalize link urls stored before canonicalization
+	err = services.Link.CanonicalizeURLs()
+	if err != nil {
+		log.Fatal("Error on canonicalize link urls: ", err)
+	}
+
+	// classify verses stored before explicit lyrics classifier
+	err = services.Audio.ClassifyLyrics()
+	if err != nil {
+		log.Fatal("Error on classify lyrics: ", err)
+	}
+
+	// closed on shutdown signal to stop background jobs
+	done := make(chan struct{})
+
+	// refresh charts rollup in background, errors are logged by service
+	go func() {
+		services.Chart.Refresh()
+		ticker := time.NewTicker(conf.Charts.RefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				services.Chart.Refresh()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// delete expired info cache entries, memory cache evicts them itself
+	if conf.InfoCache.Type == "postgres" {
+		go func() {
+			ticker := time.NewTicker(conf.InfoCache.PurgeInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+					if err := repositories.InfoCache.DeleteExpired(ctx); err != nil {
+						log.Error("Error on delete expired info cache: ", err)
+					}
+					cancel()
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
 	// init router
 	router := httprouter.New()
 
@@ -70,6 +182,12 @@ func Run() {
 		log.Info("Swagger enabled")
 	}
 
+	// serve blobs if they are not behind external url
+	if blobHandler != nil && strings.HasPrefix(conf.Blob.URL, "/") {
+		prefix := strings.TrimSuffix(conf.Blob.URL, "/")
+		router.Handler("GET", prefix+"/*filepath", http.StripPrefix(prefix, blobHandler))
+	}
+
 	// init handler
 	handler := route.NewHandler(route.Deps{
 		Service: services,
@@ -101,6 +219,7 @@ func Run() {
 	go func() {
 		sig := <-sigChan
 		log.Info("Shutdown signal:", sig)
+		close(done)
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 		server.Shutdown(ctx)
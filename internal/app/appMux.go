@@ -5,9 +5,39 @@ import (
 	"eMobile/pkg/logging"
 	"github.com/julienschmidt/httprouter"
 	"net/http"
+	"path"
+	"strings"
 	"time"
 )
 
+// defaultBodyLimit
+// max request body size of routes without own limit
+const defaultBodyLimit = 10 << 10
+
+// bodyLimits
+// routes accepting bodies larger than defaultBodyLimit,
+// pattern is matched with path.Match. Route with content type
+// matches only bodies of content type with that prefix
+var bodyLimits = []struct {
+	method      string
+	pattern     string
+	contentType string
+	limit       func(conf *config.Config) int64
+}{
+	{http.MethodPut, "/api/v1/audios/*/cover", "", coverLimit},
+	{http.MethodPut, "/api/v1/albums/*/cover", "", coverLimit},
+	{http.MethodPut, "/api/v1/audios/*/file", "", fileLimit},
+	{http.MethodPost, "/api/v1/audios", "audio/", fileLimit},
+}
+
+func coverLimit(conf *config.Config) int64 {
+	return conf.Blob.MaxCoverSize
+}
+
+func fileLimit(conf *config.Config) int64 {
+	return conf.Blob.MaxFileSize
+}
+
 type AppMux struct {
 	r    *httprouter.Router
 	l    logging.Logger
@@ -36,10 +66,24 @@ func (s *AppMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			s.PanicHandler(w, r, rcv)
 		}
 	}()
-	r.Body = http.MaxBytesReader(w, r.Body, 10<<10)
+	r.Body = http.MaxBytesReader(w, r.Body, s.bodyLimit(r))
 	s.LogMiddleware(s.r).ServeHTTP(w, r)
 }
 
+// bodyLimit
+// return max body size of request route
+func (s *AppMux) bodyLimit(r *http.Request) int64 {
+	for _, route := range bodyLimits {
+		if r.Method != route.method || !strings.HasPrefix(r.Header.Get("Content-Type"), route.contentType) {
+			continue
+		}
+		if ok, _ := path.Match(route.pattern, r.URL.Path); ok {
+			return route.limit(s.conf)
+		}
+	}
+	return defaultBodyLimit
+}
+
 // PanicHandler
 // recover panic and write 500 status
 func (s *AppMux) PanicHandler(w http.ResponseWriter, r *http.Request, rcv any) {
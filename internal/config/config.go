@@ -5,13 +5,19 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
 	"sync"
+	"time"
 )
 
 // Config
 // main config
 type Config struct {
-	Server  Server  `yaml:"server"`
-	Storage Storage `yaml:"storage"`
+	Server    Server    `yaml:"server"`
+	Storage   Storage   `yaml:"storage"`
+	InfoCache InfoCache `yaml:"info_cache"`
+	Blob      Blob      `yaml:"blob"`
+	Plays     Plays     `yaml:"plays"`
+	Charts    Charts    `yaml:"charts"`
+	Profanity Profanity `yaml:"profanity"`
 }
 
 type Server struct {
@@ -34,6 +40,52 @@ type Storage struct {
 	Migration string `yaml:"migration" env:"POSTGRES_MIGRATION"`
 }
 
+// InfoCache
+// info service response cache. Type is one of: memory | postgres | none.
+// Expired postgres entries are deleted every PurgeInterval
+type InfoCache struct {
+	Type          string        `yaml:"type" env:"APP_INFO_CACHE" env-default:"memory"`
+	Size          int           `yaml:"size" env:"APP_INFO_CACHE_SIZE" env-default:"1024"`
+	TTL           time.Duration `yaml:"ttl" env:"APP_INFO_CACHE_TTL" env-default:"24h"`
+	NotFoundTTL   time.Duration `yaml:"not_found_ttl" env:"APP_INFO_CACHE_NOT_FOUND_TTL" env-default:"1h"`
+	PurgeInterval time.Duration `yaml:"purge_interval" env:"APP_INFO_CACHE_PURGE_INTERVAL" env-default:"1h"`
+}
+
+// Blob
+// storage of uploaded files. Type is one of: local.
+// URL is prefix of public blob urls, local covers are served
+// by the app if it is a path. Audio files are never public
+type Blob struct {
+	Type         string `yaml:"type" env:"APP_BLOB_STORE" env-default:"local"`
+	Dir          string `yaml:"dir" env:"APP_BLOB_DIR" env-default:"data/blob"`
+	URL          string `yaml:"url" env:"APP_BLOB_URL" env-default:"/blob"`
+	MaxCoverSize int64  `yaml:"max_cover_size" env:"APP_MAX_COVER_SIZE" env-default:"10485760"`
+	MaxFileSize  int64  `yaml:"max_file_size" env:"APP_MAX_FILE_SIZE" env-default:"104857600"`
+}
+
+// Plays
+// listening history. Plays of audio by user within
+// DedupWindow of each other are recorded once
+type Plays struct {
+	DedupWindow time.Duration `yaml:"dedup_window" env:"APP_PLAY_DEDUP_WINDOW" env-default:"30s"`
+}
+
+// Charts
+// charts are rolled up from plays every RefreshInterval
+type Charts struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"APP_CHART_REFRESH_INTERVAL" env-default:"10m"`
+}
+
+// Profanity
+// explicit lyrics classifier. Word list of each language is read
+// from <language>.txt in Dir if present, otherwise built-in list
+// is used. Built-in languages are: en, ru. Language none disables it.
+// Stored verses are classified again on start when lists change
+type Profanity struct {
+	Languages []string `yaml:"languages" env:"APP_PROFANITY_LANGUAGES" env-default:"en,ru"`
+	Dir       string   `yaml:"dir" env:"APP_PROFANITY_DIR"`
+}
+
 var once sync.Once
 var instance *Config
 
@@ -0,0 +1,312 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strconv"
+	"strings"
+)
+
+const albumColumns = `al.uuid, al.artist_uuid, al.title, al.type, al.release_date, al.cover_key, al.created_at, al.updated_at`
+
+type AlbumCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewAlbumCRUD(c Client, l logging.Logger) *AlbumCRUD {
+	return &AlbumCRUD{db: c, logger: l}
+}
+
+// scanAlbum
+// scan row selected with albumColumns
+func scanAlbum(row pgx.Row, a *dto.AlbumRead) error {
+	return row.Scan(&a.UUID, &a.ArtistUUID, &a.Title, &a.Type, &a.ReleaseDate, &a.CoverKey, &a.CreatedAt, &a.UpdatedAt)
+}
+
+func (c *AlbumCRUD) Create(ctx context.Context, album *dto.AlbumCreate) (pgtype.UUID, error) {
+	q := `INSERT INTO public.albums
+		  (artist_uuid, title, type, release_date, created_at, updated_at)
+		  VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := c.db.QueryRow(ctx, q, album.ArtistUUID, album.Title, album.Type, album.ReleaseDate).Scan(&uuid)
+	return uuid, mapPgError(err)
+}
+
+func (c *AlbumCRUD) FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.AlbumRead, error) {
+	q := `SELECT ` + albumColumns + `
+		  FROM public.albums al
+		  WHERE al.uuid = $1`
+
+	a := dto.AlbumRead{}
+	err := scanAlbum(c.db.QueryRow(ctx, q, uuid), &a)
+	return &a, err
+}
+
+// FindByUUIDWithTracks
+// return album with tracks ordered by position
+func (c *AlbumCRUD) FindByUUIDWithTracks(ctx context.Context, uuid pgtype.UUID) (*dto.AlbumReadFull, error) {
+	qAlbum := `SELECT ` + albumColumns + `
+		  FROM public.albums al
+		  WHERE al.uuid = $1`
+
+	a := dto.AlbumReadFull{}
+	err := scanAlbum(c.db.QueryRow(ctx, qAlbum, uuid), &a.AlbumRead)
+	if err != nil {
+		return nil, err
+	}
+
+	qTracks := `SELECT ` + audioColumns + `, t.position
+		  FROM public.album_tracks t
+		  JOIN public.audios a ON a.uuid = t.audio_uuid
+		  WHERE t.album_uuid = $1
+		  ORDER BY t.position`
+
+	rows, err := c.db.Query(ctx, qTracks, uuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tracks := make([]dto.AlbumTrackRead, 0)
+	for rows.Next() {
+		t := dto.AlbumTrackRead{}
+		err = scanAudio(rows, &t.AudioRead, &t.Position)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, t)
+	}
+	a.Tracks = tracks
+
+	return &a, rows.Err()
+}
+
+func (c *AlbumCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.AlbumRead, error) {
+	q := `SELECT ` + albumColumns + `
+		  FROM public.albums al
+		  ORDER BY al.release_date NULLS LAST, al.title
+		  LIMIT $1 OFFSET $2`
+
+	rows, err := c.db.Query(ctx, q, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	albums := make([]dto.AlbumRead, 0, pag.Limit)
+	for rows.Next() {
+		a := dto.AlbumRead{}
+		err = scanAlbum(rows, &a)
+		if err != nil {
+			return nil, err
+		}
+		albums = append(albums, a)
+	}
+	return albums, rows.Err()
+}
+
+func (c *AlbumCRUD) Update(ctx context.Context, uuid pgtype.UUID, album *dto.AlbumUpdate) (*dto.AlbumRead, error) {
+	names := []string{"updated_at"}
+	ids := []string{"CURRENT_TIMESTAMP(3)"}
+	values := []any{uuid}
+	count := 2
+
+	if album.ArtistUUID.Valid {
+		names = append(names, "artist_uuid")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, album.ArtistUUID)
+		count++
+	}
+	if album.Title.Valid {
+		names = append(names, "title")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, album.Title.String)
+		count++
+	}
+	if album.Type.Valid {
+		names = append(names, "type")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, album.Type.String)
+		count++
+	}
+	if album.ReleaseDate.Valid {
+		names = append(names, "release_date")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, album.ReleaseDate)
+		count++
+	}
+
+	q := fmt.Sprintf(`UPDATE public.albums al
+		  SET (%s) = ROW(%s)
+		  WHERE al.uuid = $1
+		  RETURNING `+albumColumns, strings.Join(names, ","), strings.Join(ids, ","))
+
+	a := dto.AlbumRead{}
+	err := scanAlbum(c.db.QueryRow(ctx, q, values...), &a)
+	if err != nil {
+		return nil, mapPgError(err)
+	}
+	return &a, nil
+}
+
+// Delete
+// delete album with its tracks, audios are kept
+func (c *AlbumCRUD) Delete(ctx context.Context, uuid pgtype.UUID) error {
+	q := `DELETE FROM public.albums WHERE uuid = $1`
+
+	tag, err := c.db.Exec(ctx, q, uuid)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// lockAlbum
+// lock album row so concurrent track changes are serialized
+func lockAlbum(ctx context.Context, trx pgx.Tx, uuid pgtype.UUID) error {
+	q := `SELECT uuid FROM public.albums WHERE uuid = $1 FOR UPDATE`
+
+	return trx.QueryRow(ctx, q, uuid).Scan(&uuid)
+}
+
+// AddTrack
+// insert track at position shifting following tracks down.
+// Zero or out of range position appends track to the end
+func (c *AlbumCRUD) AddTrack(ctx context.Context, albumUUID pgtype.UUID, track *dto.AlbumTrackCreate) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	if err = lockAlbum(ctx, trx, albumUUID); err != nil {
+		return err
+	}
+
+	qLast := `SELECT COALESCE(MAX(position), 0) FROM public.album_tracks WHERE album_uuid = $1`
+	last := 0
+	if err = trx.QueryRow(ctx, qLast, albumUUID).Scan(&last); err != nil {
+		return err
+	}
+
+	position := track.Position
+	if position <= 0 || position > last {
+		position = last + 1
+	} else {
+		qShift := `UPDATE public.album_tracks
+			  SET position = position + 1
+			  WHERE album_uuid = $1 AND position >= $2`
+		if _, err = trx.Exec(ctx, qShift, albumUUID, position); err != nil {
+			return err
+		}
+	}
+
+	qTrack := `INSERT INTO public.album_tracks
+		  (album_uuid, audio_uuid, position, created_at)
+		  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3))`
+	if _, err = trx.Exec(ctx, qTrack, albumUUID, track.AudioUUID, position); err != nil {
+		return mapPgError(err)
+	}
+
+	return mapPgError(trx.Commit(ctx))
+}
+
+// RemoveTrack
+// remove track shifting following tracks up
+func (c *AlbumCRUD) RemoveTrack(ctx context.Context, albumUUID, audioUUID pgtype.UUID) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	if err = lockAlbum(ctx, trx, albumUUID); err != nil {
+		return err
+	}
+
+	qTrack := `DELETE FROM public.album_tracks
+		  WHERE album_uuid = $1 AND audio_uuid = $2
+		  RETURNING position`
+	position := 0
+	if err = trx.QueryRow(ctx, qTrack, albumUUID, audioUUID).Scan(&position); err != nil {
+		return err
+	}
+
+	qShift := `UPDATE public.album_tracks
+		  SET position = position - 1
+		  WHERE album_uuid = $1 AND position > $2`
+	if _, err = trx.Exec(ctx, qShift, albumUUID, position); err != nil {
+		return err
+	}
+
+	return mapPgError(trx.Commit(ctx))
+}
+
+// ReplaceTracks
+// set album tracks to audios in given order
+func (c *AlbumCRUD) ReplaceTracks(ctx context.Context, albumUUID pgtype.UUID, audioUUIDs []pgtype.UUID) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	if err = lockAlbum(ctx, trx, albumUUID); err != nil {
+		return err
+	}
+
+	qDelete := `DELETE FROM public.album_tracks WHERE album_uuid = $1`
+	if _, err = trx.Exec(ctx, qDelete, albumUUID); err != nil {
+		return err
+	}
+
+	if len(audioUUIDs) > 0 {
+		qTracks := `INSERT INTO public.album_tracks
+			  (album_uuid, audio_uuid, position, created_at)
+			  SELECT $1, t.audio_uuid, t.position, CURRENT_TIMESTAMP(3)
+			  FROM UNNEST($2::uuid[]) WITH ORDINALITY AS t(audio_uuid, position)`
+		if _, err = trx.Exec(ctx, qTracks, albumUUID, audioUUIDs); err != nil {
+			return mapPgError(err)
+		}
+	}
+
+	return mapPgError(trx.Commit(ctx))
+}
+
+// removeAudioTracks
+// remove tracks of audio from all albums shifting following tracks
+// up, called before audio is deleted
+func removeAudioTracks(ctx context.Context, trx pgx.Tx, audioUUID pgtype.UUID) error {
+	qLock := `SELECT al.uuid FROM public.albums al
+		  WHERE al.uuid IN (SELECT album_uuid FROM public.album_tracks WHERE audio_uuid = $1)
+		  ORDER BY al.uuid
+		  FOR UPDATE`
+	rows, err := trx.Query(ctx, qLock, audioUUID)
+	if err != nil {
+		return err
+	}
+	albums, err := pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+	if err != nil || len(albums) == 0 {
+		return err
+	}
+
+	qTracks := `WITH removed AS (
+			  DELETE FROM public.album_tracks WHERE audio_uuid = $1 RETURNING album_uuid, position
+		  )
+		  UPDATE public.album_tracks t
+		  SET position = t.position - 1
+		  FROM removed r
+		  WHERE t.album_uuid = r.album_uuid AND t.position > r.position`
+	_, err = trx.Exec(ctx, qTracks, audioUUID)
+	return err
+}
@@ -0,0 +1,376 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/canon"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strconv"
+	"strings"
+)
+
+const artistColumns = `ar.uuid, ar.name, ar.country, ar.description, ar.created_at, ar.updated_at`
+
+type ArtistCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewArtistCRUD(c Client, l logging.Logger) *ArtistCRUD {
+	return &ArtistCRUD{db: c, logger: l}
+}
+
+// scanArtist
+// scan row selected with artistColumns
+func scanArtist(row pgx.Row, a *dto.ArtistRead) error {
+	return row.Scan(&a.UUID, &a.Name, &a.Country, &a.Description, &a.CreatedAt, &a.UpdatedAt)
+}
+
+// upsertArtistByName
+// return uuid of artist with name, artist is created if not exists
+func upsertArtistByName(ctx context.Context, trx pgx.Tx, name string) (pgtype.UUID, error) {
+	q := `INSERT INTO public.artists
+		  (name, normalized_name, created_at, updated_at)
+		  VALUES ($1, $2, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := trx.QueryRow(ctx, q, name, canon.ArtistName(name)).Scan(&uuid)
+	return uuid, err
+}
+
+func (c *ArtistCRUD) Create(ctx context.Context, artist *dto.ArtistCreate) (pgtype.UUID, error) {
+	q := `INSERT INTO public.artists
+		  (name, normalized_name, country, description, created_at, updated_at)
+		  VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := c.db.QueryRow(ctx, q, artist.Name, canon.ArtistName(artist.Name), artist.Country, artist.Description).Scan(&uuid)
+	return uuid, mapPgError(err)
+}
+
+func (c *ArtistCRUD) FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.ArtistRead, error) {
+	q := `SELECT ` + artistColumns + `
+		  FROM public.artists ar
+		  WHERE ar.uuid = $1`
+
+	a := dto.ArtistRead{}
+	err := scanArtist(c.db.QueryRow(ctx, q, uuid), &a)
+	return &a, err
+}
+
+func (c *ArtistCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.ArtistRead, error) {
+	q := `SELECT ` + artistColumns + `
+		  FROM public.artists ar
+		  ORDER BY ar.name
+		  LIMIT $1 OFFSET $2`
+
+	rows, err := c.db.Query(ctx, q, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	artists := make([]dto.ArtistRead, 0, pag.Limit)
+	for rows.Next() {
+		a := dto.ArtistRead{}
+		err = scanArtist(rows, &a)
+		if err != nil {
+			return nil, err
+		}
+		artists = append(artists, a)
+	}
+	return artists, rows.Err()
+}
+
+// ListAudios
+// return audios of artist
+func (c *ArtistCRUD) ListAudios(ctx context.Context, uuid pgtype.UUID, pag Pagination) ([]dto.AudioRead, error) {
+	q := `SELECT ` + audioColumns + `
+		  FROM public.audios a
+		  WHERE a.artist_uuid = $1
+		  ORDER BY a.release_date, a.song
+		  LIMIT $2 OFFSET $3`
+
+	rows, err := c.db.Query(ctx, q, uuid, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	audios := make([]dto.AudioRead, 0, pag.Limit)
+	for rows.Next() {
+		a := dto.AudioRead{}
+		err = scanAudio(rows, &a)
+		if err != nil {
+			return nil, err
+		}
+		audios = append(audios, a)
+	}
+	return audios, rows.Err()
+}
+
+// Update
+// update artist, on rename denormalized audios."group" is updated too
+func (c *ArtistCRUD) Update(ctx context.Context, uuid pgtype.UUID, artist *dto.ArtistUpdate) (*dto.ArtistRead, error) {
+	names := []string{"updated_at"}
+	ids := []string{"CURRENT_TIMESTAMP(3)"}
+	values := []any{uuid}
+	count := 2
+
+	if artist.Name.Valid {
+		names = append(names, "name", "normalized_name")
+		ids = append(ids, "$"+strconv.Itoa(count), "$"+strconv.Itoa(count+1))
+		values = append(values, artist.Name.String, canon.ArtistName(artist.Name.String))
+		count += 2
+	}
+	if artist.Country.Valid {
+		names = append(names, "country")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, artist.Country.String)
+		count++
+	}
+	if artist.Description.Valid {
+		names = append(names, "description")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, artist.Description.String)
+		count++
+	}
+
+	q := fmt.Sprintf(`UPDATE public.artists ar
+		  SET (%s) = ROW(%s)
+		  WHERE ar.uuid = $1
+		  RETURNING `+artistColumns, strings.Join(names, ","), strings.Join(ids, ","))
+
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer trx.Rollback(ctx)
+
+	a := dto.ArtistRead{}
+	err = scanArtist(trx.QueryRow(ctx, q, values...), &a)
+	if err != nil {
+		return nil, mapPgError(err)
+	}
+
+	if artist.Name.Valid {
+		qAudios := `UPDATE public.audios
+			  SET "group" = $2, updated_at = CURRENT_TIMESTAMP(3)
+			  WHERE artist_uuid = $1 AND "group" <> $2
+			  RETURNING uuid`
+		err = rewriteAudiosGroup(ctx, trx, qAudios, artist.Editor, uuid, artist.Name.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &a, trx.Commit(ctx)
+}
+
+// Delete
+// delete artist without audios
+func (c *ArtistCRUD) Delete(ctx context.Context, uuid pgtype.UUID) error {
+	q := `DELETE FROM public.artists WHERE uuid = $1`
+
+	tag, err := c.db.Exec(ctx, q, uuid)
+	if err != nil {
+		return mapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// FindByAlias
+// return artist whose alias or name has the same canonical form as name.
+// Aliases take precedence, among artists the oldest one is returned
+func (c *ArtistCRUD) FindByAlias(ctx context.Context, name string) (*dto.ArtistRead, error) {
+	q := `SELECT ` + artistColumns + `
+		  FROM public.artists ar
+		  LEFT JOIN public.artist_aliases al ON al.artist_uuid = ar.uuid AND al.normalized = $1
+		  WHERE al.uuid IS NOT NULL OR ar.normalized_name = $1
+		  ORDER BY al.uuid IS NULL, ar.created_at
+		  LIMIT 1`
+
+	a := dto.ArtistRead{}
+	err := scanArtist(c.db.QueryRow(ctx, q, canon.ArtistName(name)), &a)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// FillNormalizedNames
+// set normalized_name of artists where it is not set yet.
+// Return number of updated artists
+func (c *ArtistCRUD) FillNormalizedNames(ctx context.Context) (int, error) {
+	q := `SELECT uuid, name FROM public.artists WHERE normalized_name IS NULL`
+
+	rows, err := c.db.Query(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+	type artist struct {
+		uuid pgtype.UUID
+		name string
+	}
+	var artists []artist
+	for rows.Next() {
+		a := artist{}
+		if err = rows.Scan(&a.uuid, &a.name); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		artists = append(artists, a)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	qUpdate := `UPDATE public.artists SET normalized_name = $2 WHERE uuid = $1`
+	for i := 0; i < len(artists); i++ {
+		_, err = c.db.Exec(ctx, qUpdate, artists[i].uuid, canon.ArtistName(artists[i].name))
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(artists), nil
+}
+
+func (c *ArtistCRUD) ListAliases(ctx context.Context, artistUUID pgtype.UUID) ([]dto.ArtistAliasRead, error) {
+	q := `SELECT uuid, artist_uuid, alias, normalized, created_at
+		  FROM public.artist_aliases
+		  WHERE artist_uuid = $1
+		  ORDER BY alias`
+
+	rows, err := c.db.Query(ctx, q, artistUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aliases := make([]dto.ArtistAliasRead, 0)
+	for rows.Next() {
+		a := dto.ArtistAliasRead{}
+		err = rows.Scan(&a.UUID, &a.ArtistUUID, &a.Alias, &a.Normalized, &a.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, a)
+	}
+	return aliases, rows.Err()
+}
+
+func (c *ArtistCRUD) CreateAlias(ctx context.Context, artistUUID pgtype.UUID, alias *dto.ArtistAliasCreate) (pgtype.UUID, error) {
+	q := `INSERT INTO public.artist_aliases
+		  (artist_uuid, alias, normalized, created_at)
+		  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3))
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := c.db.QueryRow(ctx, q, artistUUID, alias.Alias, canon.ArtistName(alias.Alias)).Scan(&uuid)
+	return uuid, mapPgError(err)
+}
+
+func (c *ArtistCRUD) DeleteAlias(ctx context.Context, artistUUID, aliasUUID pgtype.UUID) error {
+	q := `DELETE FROM public.artist_aliases WHERE uuid = $1 AND artist_uuid = $2`
+
+	tag, err := c.db.Exec(ctx, q, aliasUUID, artistUUID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// Merge
+// move audios, albums and aliases of source artist to target, keep
+// source name as target alias and delete source
+func (c *ArtistCRUD) Merge(ctx context.Context, targetUUID, sourceUUID pgtype.UUID, editor string) (*dto.ArtistRead, error) {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer trx.Rollback(ctx)
+
+	qArtist := `SELECT ` + artistColumns + `
+		  FROM public.artists ar
+		  WHERE ar.uuid = $1
+		  FOR UPDATE`
+
+	source := dto.ArtistRead{}
+	if err = scanArtist(trx.QueryRow(ctx, qArtist, sourceUUID), &source); err != nil {
+		return nil, err
+	}
+	target := dto.ArtistRead{}
+	if err = scanArtist(trx.QueryRow(ctx, qArtist, targetUUID), &target); err != nil {
+		return nil, err
+	}
+
+	qAudios := `UPDATE public.audios
+		  SET artist_uuid = $1, "group" = $3, updated_at = CURRENT_TIMESTAMP(3)
+		  WHERE artist_uuid = $2
+		  RETURNING uuid`
+	if err = rewriteAudiosGroup(ctx, trx, qAudios, editor, targetUUID, sourceUUID, target.Name); err != nil {
+		return nil, err
+	}
+
+	qAliases := `UPDATE public.artist_aliases SET artist_uuid = $1 WHERE artist_uuid = $2`
+	if _, err = trx.Exec(ctx, qAliases, targetUUID, sourceUUID); err != nil {
+		return nil, err
+	}
+
+	// albums restrict artist delete, so they are moved as well
+	qAlbums := `UPDATE public.albums SET artist_uuid = $1, updated_at = CURRENT_TIMESTAMP(3) WHERE artist_uuid = $2`
+	if _, err = trx.Exec(ctx, qAlbums, targetUUID, sourceUUID); err != nil {
+		return nil, err
+	}
+
+	normalized := canon.ArtistName(source.Name)
+	if normalized != canon.ArtistName(target.Name) {
+		qAlias := `INSERT INTO public.artist_aliases
+			  (artist_uuid, alias, normalized, created_at)
+			  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3))
+			  ON CONFLICT (normalized) DO UPDATE SET artist_uuid = EXCLUDED.artist_uuid`
+		if _, err = trx.Exec(ctx, qAlias, targetUUID, source.Name, normalized); err != nil {
+			return nil, err
+		}
+	}
+
+	qDelete := `DELETE FROM public.artists WHERE uuid = $1`
+	if _, err = trx.Exec(ctx, qDelete, sourceUUID); err != nil {
+		return nil, err
+	}
+
+	return &target, trx.Commit(ctx)
+}
+
+// rewriteAudiosGroup
+// run query rewriting group of audios and returning their uuids,
+// group of rewritten audios gets manual provenance of editor
+func rewriteAudiosGroup(ctx context.Context, trx pgx.Tx, q, editor string, args ...any) error {
+	rows, err := trx.Query(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	audios, err := pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+	if err != nil {
+		return err
+	}
+
+	return upsertAudiosProvenance(ctx, trx, audios, dto.ProvenanceCreate{
+		Field:  dto.FieldGroup,
+		Source: dto.SourceManual,
+		Editor: editor,
+	})
+}
@@ -3,7 +3,9 @@ package crud
 import (
 	"context"
 	"eMobile/internal/dto"
+	"eMobile/pkg/canon"
 	"eMobile/pkg/logging"
+	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -11,6 +13,11 @@ import (
 	"strings"
 )
 
+// audioColumns
+// columns selected for dto.AudioRead, table must be aliased as "a"
+const audioColumns = `a.uuid, a.artist_uuid, a."group", a.song, a.release_date, a.release_date_precision, a.link,
+	a.duration_ms, a.isrc, a.bpm, a.musical_key, a.explicit, a.source, a.cover_key, a.play_count, a.created_at, a.updated_at`
+
 type AudioCRUD struct {
 	db     Client
 	logger logging.Logger
@@ -22,8 +29,9 @@ func NewAudioCRUD(c Client, l logging.Logger) *AudioCRUD {
 
 func (c *AudioCRUD) CreateWithLyrics(ctx context.Context, audio *dto.AudioCreateFull) (pgtype.UUID, error) {
 	qAudio := `INSERT INTO public.audios 
-    	  ("group", song, release_date, link, created_at, updated_at)
-    	  VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+    	  (artist_uuid, "group", song, release_date, release_date_precision, link,
+    	   duration_ms, isrc, bpm, musical_key, explicit, source, created_at, updated_at)
+    	  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
     	  RETURNING uuid;`
 
 	// insert audio
@@ -34,7 +42,13 @@ func (c *AudioCRUD) CreateWithLyrics(ctx context.Context, audio *dto.AudioCreate
 	}
 	defer trx.Rollback(ctx)
 
-	err = trx.QueryRow(ctx, qAudio, audio.Group, audio.Song, audio.ReleaseDate, audio.Link).Scan(&uuid)
+	artistUUID, err := upsertArtistByName(ctx, trx, audio.Group)
+	if err != nil {
+		return uuid, err
+	}
+
+	err = trx.QueryRow(ctx, qAudio, artistUUID, audio.Group, audio.Song, audio.ReleaseDate,
+		releaseDatePrecision(audio.ReleaseDatePrecision), audio.Link, audio.DurationMs, audio.ISRC, audio.BPM, audio.Key, audio.Explicit, audio.Source).Scan(&uuid)
 	if err != nil {
 		return uuid, err
 	}
@@ -44,12 +58,26 @@ func (c *AudioCRUD) CreateWithLyrics(ctx context.Context, audio *dto.AudioCreate
 		return uuid, err
 	}
 
+	err = setPrimaryLink(ctx, trx, uuid, audio.Link, linkSource(audio.Provenance))
+	if err != nil {
+		return uuid, err
+	}
+
+	err = upsertProvenance(ctx, trx, uuid, audio.Provenance)
+	if err != nil {
+		return uuid, err
+	}
+
 	return uuid, trx.Commit(ctx)
 }
 
 func (c *AudioCRUD) insertLyrics(ctx context.Context, trx pgx.Tx, audioUUID pgtype.UUID, lyrics []dto.LyricCreate) error {
+	if len(lyrics) == 0 {
+		return nil
+	}
+
 	qLyrics := `INSERT INTO public.lyrics
-				(audio_uuid, "order", text, created_at, updated_at)
+				(audio_uuid, "order", text, explicit, created_at, updated_at)
 				VALUES `
 
 	// prepare lyrics query
@@ -57,13 +85,13 @@ func (c *AudioCRUD) insertLyrics(ctx context.Context, trx pgx.Tx, audioUUID pgty
 	qValues := make([]string, 0, len(lyrics))
 	values := make([]any, 0, len(lyrics))
 	for i := 0; i < len(lyrics); i++ {
-		qValue := fmt.Sprintf("($%d, $%d, $%d, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))",
-			counter, counter+1, counter+2)
-		counter += 3
+		qValue := fmt.Sprintf("($%d, $%d, $%d, $%d, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))",
+			counter, counter+1, counter+2, counter+3)
+		counter += 4
 		qValues = append(qValues, qValue)
 
 		lyric := &lyrics[i]
-		values = append(values, audioUUID, lyric.Order, lyric.Text)
+		values = append(values, audioUUID, lyric.Order, lyric.Text, lyric.Explicit)
 	}
 
 	qLyrics += strings.Join(qValues, ",") + ";"
@@ -73,9 +101,18 @@ func (c *AudioCRUD) insertLyrics(ctx context.Context, trx pgx.Tx, audioUUID pgty
 	return err
 }
 
+// scanAudio
+// scan row selected with audioColumns, extra columns selected after
+// audioColumns are scanned into extra
+func scanAudio(row pgx.Row, a *dto.AudioRead, extra ...any) error {
+	dest := []any{&a.UUID, &a.ArtistUUID, &a.Group, &a.Song, &a.ReleaseDate, &a.ReleaseDatePrecision, &a.Link,
+		&a.DurationMs, &a.ISRC, &a.BPM, &a.Key, &a.Explicit, &a.Source, &a.CoverKey, &a.PlayCount, &a.CreatedAt, &a.UpdatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
 func (c *AudioCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.AudioRead, error) {
-	q := `SELECT uuid, "group", song, release_date, link, created_at, updated_at
-		  FROM public.audios
+	q := `SELECT ` + audioColumns + `
+		  FROM public.audios a
 		  LIMIT $1 OFFSET $2`
 
 	rows, err := c.db.Query(ctx, q, pag.Limit, pag.Offset)
@@ -87,7 +124,7 @@ func (c *AudioCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.AudioR
 	audios := make([]dto.AudioRead, 0, pag.Limit)
 	for rows.Next() {
 		a := dto.AudioRead{}
-		err = rows.Scan(&a.UUID, &a.Group, &a.Song, &a.ReleaseDate, &a.Link, &a.CreatedAt, &a.UpdatedAt)
+		err = scanAudio(rows, &a)
 		if err != nil {
 			return nil, err
 		}
@@ -97,29 +134,29 @@ func (c *AudioCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.AudioR
 }
 
 func (c *AudioCRUD) FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.AudioRead, error) {
-	q := `SELECT uuid, "group", song, release_date, link, created_at, updated_at
-		  FROM public.audios 
-		  WHERE uuid=$1`
+	q := `SELECT ` + audioColumns + `
+		  FROM public.audios a
+		  WHERE a.uuid=$1`
 	a := dto.AudioRead{}
-	err := c.db.QueryRow(ctx, q, uuid).Scan(&a.UUID, &a.Group, &a.Song, &a.ReleaseDate, &a.Link, &a.CreatedAt, &a.UpdatedAt)
+	err := scanAudio(c.db.QueryRow(ctx, q, uuid), &a)
 
 	return &a, err
 }
 
 func (c *AudioCRUD) FindByUUIDWithLyrics(ctx context.Context, uuid pgtype.UUID) (*dto.AudioReadFull, error) {
 	// select audio
-	qAudio := `SELECT uuid, "group", song, release_date, link, created_at, updated_at
-		  FROM public.audios 
-		  WHERE uuid=$1`
+	qAudio := `SELECT ` + audioColumns + `
+		  FROM public.audios a
+		  WHERE a.uuid=$1`
 
 	a := dto.AudioReadFull{}
-	err := c.db.QueryRow(ctx, qAudio, uuid).Scan(&a.UUID, &a.Group, &a.Song, &a.ReleaseDate, &a.Link, &a.CreatedAt, &a.UpdatedAt)
+	err := scanAudio(c.db.QueryRow(ctx, qAudio, uuid), &a.AudioRead)
 	if err != nil {
 		return nil, err
 	}
 
 	// select lyrics rows
-	qLyrics := `SELECT uuid, audio_uuid, "order", text, created_at, updated_at
+	qLyrics := `SELECT ` + lyricColumns + `
 				FROM public.lyrics
 				WHERE audio_uuid = $1
 		  		ORDER BY "order"`
@@ -133,26 +170,63 @@ func (c *AudioCRUD) FindByUUIDWithLyrics(ctx context.Context, uuid pgtype.UUID)
 
 	for rows.Next() {
 		lyric := dto.LyricRead{}
-		err = rows.Scan(&lyric.UUID, &lyric.AudioUUID, &lyric.Order, &lyric.Text, &lyric.CreatedAt, &lyric.UpdatedAt)
+		err = scanLyric(rows, &lyric)
 		if err != nil {
 			return nil, err
 		}
 		lyrics = append(lyrics, lyric)
 	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
 	a.Lyrics = lyrics
 
-	return &a, rows.Err()
+	// select album, earliest released one if audio is on several
+	qAlbum := `SELECT al.uuid, al.title, al.type, al.release_date, t.position
+			   FROM public.album_tracks t
+			   JOIN public.albums al ON al.uuid = t.album_uuid
+			   WHERE t.audio_uuid = $1
+			   ORDER BY al.release_date NULLS LAST, al.created_at
+			   LIMIT 1`
+
+	album := dto.AudioAlbum{}
+	err = c.db.QueryRow(ctx, qAlbum, uuid).Scan(&album.UUID, &album.Title, &album.Type, &album.ReleaseDate, &album.Position)
+	if err == nil {
+		a.Album = &album
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	a.Credits, err = selectCredits(ctx, c.db, uuid)
+	if err != nil {
+		return nil, err
+	}
+	a.Genres, err = selectAudioGenres(ctx, c.db, uuid)
+	if err != nil {
+		return nil, err
+	}
+	a.Tags, err = selectAudioTags(ctx, c.db, uuid)
+	if err != nil {
+		return nil, err
+	}
+	a.Links, err = selectLinks(ctx, c.db, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
 }
 
 func (c *AudioCRUD) ListByFilter(ctx context.Context, filter *dto.AudioFilter, pag Pagination) ([]dto.AudioRead, error) {
 	var baseQuery string
 	if filter.Lyric.Valid {
-		baseQuery = `SELECT DISTINCT a.uuid, a."group", a.song, a.release_date, a.link, a.created_at, a.updated_at
+		baseQuery = `SELECT DISTINCT ` + audioColumns + `
 					  FROM public.audios a
 					  JOIN public.lyrics l ON a.uuid = l.audio_uuid
 					  WHERE `
 	} else {
-		baseQuery = `SELECT uuid, "group", song, release_date, link, created_at, updated_at 
+		baseQuery = `SELECT ` + audioColumns + `
 				 	  FROM public.audios a
 				 	  WHERE `
 	}
@@ -163,7 +237,7 @@ func (c *AudioCRUD) ListByFilter(ctx context.Context, filter *dto.AudioFilter, p
 	values = append(values, pag.Limit, pag.Offset)
 
 	q, values := c.buildWhereQuery(baseQuery, filter, values)
-	q += endQuery
+	q += orderBy(filter) + endQuery
 
 	rows, err := c.db.Query(ctx, q, values...)
 	if err != nil {
@@ -174,7 +248,7 @@ func (c *AudioCRUD) ListByFilter(ctx context.Context, filter *dto.AudioFilter, p
 	audios := make([]dto.AudioRead, 0, pag.Limit)
 	for rows.Next() {
 		a := dto.AudioRead{}
-		err = rows.Scan(&a.UUID, &a.Group, &a.Song, &a.ReleaseDate, &a.Link, &a.CreatedAt, &a.UpdatedAt)
+		err = scanAudio(rows, &a)
 		if err != nil {
 			return nil, err
 		}
@@ -185,15 +259,23 @@ func (c *AudioCRUD) ListByFilter(ctx context.Context, filter *dto.AudioFilter, p
 
 func (c *AudioCRUD) buildWhereQuery(base string, filter *dto.AudioFilter, values []any) (string, []any) {
 	conditions := make([]string, 0, 6)
-	counter := 3
+	counter := len(values) + 1
 
-	if filter.Group.Valid {
+	if filter.ArtistUUID.Valid {
+		conditions = append(conditions, "a.artist_uuid = $"+strconv.Itoa(counter))
+		values = append(values, filter.ArtistUUID)
+		counter++
+	} else if filter.Group.Valid {
 		conditions = append(conditions, "a.group = $"+strconv.Itoa(counter))
 		values = append(values, filter.Group.String)
 		counter++
 	}
+	// release date is a period of its precision, filters match overlapping periods
 	if filter.ReleaseDateAfter.Valid {
-		conditions = append(conditions, "a.release_date >= $"+strconv.Itoa(counter))
+		conditions = append(conditions, `a.release_date + CASE a.release_date_precision
+			  WHEN 'year' THEN INTERVAL '1 year'
+			  WHEN 'month' THEN INTERVAL '1 month'
+			  ELSE INTERVAL '1 day' END > $`+strconv.Itoa(counter))
 		values = append(values, filter.ReleaseDateAfter)
 		counter++
 	}
@@ -203,7 +285,9 @@ func (c *AudioCRUD) buildWhereQuery(base string, filter *dto.AudioFilter, values
 		counter++
 	}
 	if filter.Link.Valid {
-		conditions = append(conditions, "a.link = $"+strconv.Itoa(counter))
+		// any link of audio, filter link is canonicalized like stored ones
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM public.audio_links li
+			  WHERE li.audio_uuid = a.uuid AND li.url = $`+strconv.Itoa(counter)+`)`)
 		values = append(values, filter.Link.String)
 		counter++
 	}
@@ -219,20 +303,119 @@ func (c *AudioCRUD) buildWhereQuery(base string, filter *dto.AudioFilter, values
 		values = append(values, filter.Lyric.String)
 		counter++
 	}
+	if filter.Credit.Valid {
+		credit := `EXISTS (SELECT 1 FROM public.audio_credits c
+			  JOIN public.persons p ON p.uuid = c.person_uuid
+			  WHERE c.audio_uuid = a.uuid AND p.normalized_name = $` + strconv.Itoa(counter)
+		values = append(values, canon.ArtistName(filter.Credit.String))
+		counter++
+		if filter.CreditRole.Valid {
+			credit += " AND c.role = $" + strconv.Itoa(counter)
+			values = append(values, filter.CreditRole.String)
+			counter++
+		}
+		conditions = append(conditions, credit+")")
+	}
+	if filter.DurationMin.Valid {
+		conditions = append(conditions, "a.duration_ms >= $"+strconv.Itoa(counter))
+		values = append(values, filter.DurationMin)
+		counter++
+	}
+	if filter.DurationMax.Valid {
+		conditions = append(conditions, "a.duration_ms <= $"+strconv.Itoa(counter))
+		values = append(values, filter.DurationMax)
+		counter++
+	}
+	if filter.ISRC.Valid {
+		conditions = append(conditions, "a.isrc = $"+strconv.Itoa(counter))
+		values = append(values, filter.ISRC.String)
+		counter++
+	}
+	if filter.Explicit.Valid {
+		conditions = append(conditions, "a.explicit = $"+strconv.Itoa(counter))
+		values = append(values, filter.Explicit.Bool)
+		counter++
+	}
+	if filter.Platform.Valid {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM public.audio_links li
+			  WHERE li.audio_uuid = a.uuid AND li.platform = $`+strconv.Itoa(counter)+`)`)
+		values = append(values, filter.Platform.String)
+		counter++
+	}
+	for _, genres := range matchGroups(filter.Genres, filter.GenresMatchAll) {
+		conditions = append(conditions, genreCondition("$"+strconv.Itoa(counter)))
+		values = append(values, genres)
+		counter++
+	}
+	for _, tags := range matchGroups(filter.Tags, filter.TagsMatchAll) {
+		conditions = append(conditions, tagCondition("$"+strconv.Itoa(counter)))
+		values = append(values, tags)
+		counter++
+	}
+
+	// filter may only sort audios
+	if len(conditions) == 0 {
+		conditions = append(conditions, "TRUE")
+	}
 
 	base += strings.Join(conditions, " AND ")
 	return base, values
 }
 
-func (c *AudioCRUD) Update(ctx context.Context, uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.AudioRead, error) {
-	baseQuery := `UPDATE public.audios 
-		  SET (updated_at, %s) = ROW(CURRENT_TIMESTAMP(3), %s) 
-		  WHERE uuid=$1 
-		  RETURNING uuid, "group", song, release_date, link, created_at, updated_at;`
+// audioSortColumns
+// columns of audio list sort keys
+var audioSortColumns = map[string]string{
+	dto.SortPlayCount:   "a.play_count",
+	dto.SortCreatedAt:   "a.created_at",
+	dto.SortReleaseDate: "a.release_date",
+}
 
-	var values []any
-	values = append(values, uuid)
-	q, values := c.buildUpdateQuery(baseQuery, values, audio)
+// orderBy
+// return ORDER BY clause of filter sort, uuid keeps pages stable
+func orderBy(filter *dto.AudioFilter) string {
+	column, ok := audioSortColumns[filter.Sort.String]
+	if !filter.Sort.Valid || !ok {
+		return ""
+	}
+	if filter.SortDesc {
+		column += " DESC NULLS LAST"
+	} else {
+		column += " NULLS LAST"
+	}
+	return " ORDER BY " + column + ", a.uuid"
+}
+
+// releaseDatePrecision
+// return precision of release date, day if not set
+func releaseDatePrecision(precision string) string {
+	if precision == "" {
+		return dto.PrecisionDay
+	}
+	return precision
+}
+
+// matchGroups
+// split filter values into groups each of which must be matched:
+// one group of all values for any semantics, group per value for all
+func matchGroups(values []string, all bool) [][]string {
+	if len(values) == 0 {
+		return nil
+	}
+	if !all {
+		return [][]string{values}
+	}
+	groups := make([][]string, 0, len(values))
+	for _, v := range values {
+		groups = append(groups, []string{v})
+	}
+	return groups
+}
+
+func (c *AudioCRUD) Update(ctx context.Context, uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.AudioRead, error) {
+	baseQuery := `UPDATE public.audios a
+		  SET (%s) = ROW(%s) 
+		  WHERE a.uuid=$1 
+		  RETURNING ` + audioColumns + `;`
 
 	trx, err := c.db.Begin(ctx)
 	if err != nil {
@@ -240,10 +423,21 @@ func (c *AudioCRUD) Update(ctx context.Context, uuid pgtype.UUID, audio *dto.Aud
 	}
 	defer trx.Rollback(ctx)
 
+	artistUUID := pgtype.UUID{}
+	if audio.Group.Valid {
+		artistUUID, err = upsertArtistByName(ctx, trx, audio.Group.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var values []any
+	values = append(values, uuid)
+	q, values := c.buildUpdateQuery(baseQuery, values, audio, artistUUID)
+
 	// Update audio
 	rAudio := dto.AudioRead{}
-	err = trx.QueryRow(ctx, q, values...).
-		Scan(&rAudio.UUID, &rAudio.Group, &rAudio.Song, &rAudio.ReleaseDate, &rAudio.Link, &rAudio.CreatedAt, &rAudio.UpdatedAt)
+	err = scanAudio(trx.QueryRow(ctx, q, values...), &rAudio)
 	if err != nil {
 		return nil, err
 	}
@@ -262,19 +456,31 @@ func (c *AudioCRUD) Update(ctx context.Context, uuid pgtype.UUID, audio *dto.Aud
 		}
 	}
 
+	if audio.Link.Valid {
+		err = setPrimaryLink(ctx, trx, rAudio.UUID, audio.Link.String, linkSource(audio.Provenance))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = upsertProvenance(ctx, trx, rAudio.UUID, audio.Provenance)
+	if err != nil {
+		return nil, err
+	}
+
 	return &rAudio, trx.Commit(ctx)
 }
 
-func (c *AudioCRUD) buildUpdateQuery(base string, values []any, audio *dto.AudioUpdate) (string, []any) {
-	var names []string
-	var ids []string
+func (c *AudioCRUD) buildUpdateQuery(base string, values []any, audio *dto.AudioUpdate, artistUUID pgtype.UUID) (string, []any) {
+	names := []string{"updated_at"}
+	ids := []string{"CURRENT_TIMESTAMP(3)"}
 	count := 2
 
 	if audio.Group.Valid {
-		names = append(names, "\"group\"")
-		ids = append(ids, "$"+strconv.Itoa(count))
-		values = append(values, audio.Group.String)
-		count++
+		names = append(names, "\"group\"", "artist_uuid")
+		ids = append(ids, "$"+strconv.Itoa(count), "$"+strconv.Itoa(count+1))
+		values = append(values, audio.Group.String, artistUUID)
+		count += 2
 	}
 	if audio.Song.Valid {
 		names = append(names, "song")
@@ -283,10 +489,10 @@ func (c *AudioCRUD) buildUpdateQuery(base string, values []any, audio *dto.Audio
 		count++
 	}
 	if audio.ReleaseDate.Valid {
-		names = append(names, "release_date")
-		ids = append(ids, "$"+strconv.Itoa(count))
-		values = append(values, audio.ReleaseDate)
-		count++
+		names = append(names, "release_date", "release_date_precision")
+		ids = append(ids, "$"+strconv.Itoa(count), "$"+strconv.Itoa(count+1))
+		values = append(values, audio.ReleaseDate, releaseDatePrecision(audio.ReleaseDatePrecision))
+		count += 2
 	}
 	if audio.Link.Valid {
 		names = append(names, "link")
@@ -294,20 +500,81 @@ func (c *AudioCRUD) buildUpdateQuery(base string, values []any, audio *dto.Audio
 		values = append(values, audio.Link.String)
 		count++
 	}
+	for _, f := range []struct {
+		name  string
+		valid bool
+		value any
+	}{
+		{"duration_ms", audio.DurationMs.Valid, audio.DurationMs},
+		{"isrc", audio.ISRC.Valid, audio.ISRC},
+		{"bpm", audio.BPM.Valid, audio.BPM},
+		{"musical_key", audio.Key.Valid, audio.Key},
+		{"explicit", audio.Explicit.Valid, audio.Explicit},
+	} {
+		if f.valid {
+			names = append(names, f.name)
+			ids = append(ids, "$"+strconv.Itoa(count))
+			values = append(values, f.value)
+			count++
+		}
+	}
 
 	q := fmt.Sprintf(base, strings.Join(names, ","), strings.Join(ids, ","))
 	return q, values
 }
 
-func (c *AudioCRUD) Delete(ctx context.Context, uuid pgtype.UUID) error {
+// Delete
+// delete audio, its playlist entries and album tracks are removed
+// keeping playlist and album positions contiguous. Return blob keys
+// of audio file and cover with thumbnails left for caller to remove
+func (c *AudioCRUD) Delete(ctx context.Context, uuid pgtype.UUID) ([]string, error) {
 	q := "DELETE FROM public.audios WHERE uuid=$1"
 
-	tag, err := c.db.Exec(ctx, q, uuid)
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer trx.Rollback(ctx)
+
+	err = removeAudioEntries(ctx, trx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = removeAudioTracks(ctx, trx, uuid)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	qKeys := `SELECT a.cover_key, f.blob_key
+		  FROM public.audios a
+		  LEFT JOIN public.audio_files f ON f.audio_uuid = a.uuid
+		  WHERE a.uuid = $1`
+	var coverKey, fileKey pgtype.Text
+	if err = trx.QueryRow(ctx, qKeys, uuid).Scan(&coverKey, &fileKey); err != nil {
+		return nil, err
+	}
+
+	tag, err := trx.Exec(ctx, q, uuid)
+	if err != nil {
+		return nil, err
 	}
 	if tag.RowsAffected() == 0 {
-		return pgx.ErrNoRows
+		return nil, pgx.ErrNoRows
+	}
+	if err = trx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	if fileKey.Valid {
+		keys = append(keys, fileKey.String)
+	}
+	if coverKey.Valid {
+		keys = append(keys, coverKey.String)
+		for _, size := range dto.CoverSizes {
+			keys = append(keys, dto.CoverThumbnailKey(coverKey.String, size))
+		}
 	}
-	return nil
+	return keys, nil
 }
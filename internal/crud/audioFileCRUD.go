@@ -0,0 +1,62 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const audioFileColumns = `f.audio_uuid, f.blob_key, f.format, f.size, f.sha256, f.duration_ms, f.created_at`
+
+type AudioFileCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewAudioFileCRUD(c Client, l logging.Logger) *AudioFileCRUD {
+	return &AudioFileCRUD{db: c, logger: l}
+}
+
+// scanAudioFile
+// scan row selected with audioFileColumns followed by extra columns
+func scanAudioFile(row pgx.Row, f *dto.AudioFileRead, extra ...any) error {
+	dest := []any{&f.AudioUUID, &f.BlobKey, &f.Format, &f.Size, &f.SHA256, &f.DurationMs, &f.CreatedAt}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// Upsert
+// attach file to audio replacing previous one, return stored file
+// and blob key of replaced file, invalid if audio had no file
+func (c *AudioFileCRUD) Upsert(ctx context.Context, audioUUID pgtype.UUID, file *dto.AudioFileCreate) (*dto.AudioFileRead, pgtype.Text, error) {
+	q := `WITH old AS (
+			  SELECT blob_key FROM public.audio_files WHERE audio_uuid = $1 FOR UPDATE
+		  )
+		  INSERT INTO public.audio_files AS f
+		  (audio_uuid, blob_key, format, size, sha256, duration_ms, created_at)
+		  VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (audio_uuid) DO UPDATE
+		  SET blob_key = EXCLUDED.blob_key, format = EXCLUDED.format, size = EXCLUDED.size,
+		      sha256 = EXCLUDED.sha256, duration_ms = EXCLUDED.duration_ms, created_at = EXCLUDED.created_at
+		  RETURNING ` + audioFileColumns + `, (SELECT blob_key FROM old)`
+
+	f := dto.AudioFileRead{}
+	old := pgtype.Text{}
+	row := c.db.QueryRow(ctx, q, audioUUID, file.BlobKey, file.Format, file.Size, file.SHA256, file.DurationMs)
+	err := scanAudioFile(row, &f, &old)
+	if err != nil {
+		return nil, old, mapPgError(err)
+	}
+	return &f, old, nil
+}
+
+func (c *AudioFileCRUD) FindByAudio(ctx context.Context, audioUUID pgtype.UUID) (*dto.AudioFileRead, error) {
+	q := `SELECT ` + audioFileColumns + `
+		  FROM public.audio_files f
+		  WHERE f.audio_uuid = $1`
+
+	f := dto.AudioFileRead{}
+	err := scanAudioFile(c.db.QueryRow(ctx, q, audioUUID), &f)
+	return &f, err
+}
@@ -0,0 +1,103 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+)
+
+// Stats
+// aggregate audios matched by filter. Aggregates are read
+// in one snapshot, so they are consistent with each other
+func (c *AudioCRUD) Stats(ctx context.Context, filter *dto.AudioFilter) (*dto.AudioStats, error) {
+	var baseQuery string
+	if filter.Lyric.Valid {
+		baseQuery = `WITH filtered AS (
+			  SELECT DISTINCT a.uuid, a."group", a.release_date, a.link,
+			  (SELECT COUNT(*) FROM public.lyrics lv WHERE lv.audio_uuid = a.uuid) AS verses
+			  FROM public.audios a
+			  JOIN public.lyrics l ON a.uuid = l.audio_uuid
+			  WHERE `
+	} else {
+		baseQuery = `WITH filtered AS (
+			  SELECT a.uuid, a."group", a.release_date, a.link,
+			  (SELECT COUNT(*) FROM public.lyrics lv WHERE lv.audio_uuid = a.uuid) AS verses
+			  FROM public.audios a
+			  WHERE `
+	}
+
+	filtered, values := c.buildWhereQuery(baseQuery, filter, make([]any, 0, 8))
+	filtered += `) `
+
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer trx.Rollback(ctx)
+
+	_, err = trx.Exec(ctx, `SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY`)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := dto.AudioStats{
+		ByGroup: make([]dto.GroupAudioStat, 0),
+		ByYear:  make([]dto.YearAudioStat, 0),
+	}
+
+	qTotals := filtered + `SELECT COUNT(*),
+		  COUNT(*) FILTER (WHERE f.verses = 0),
+		  COUNT(*) FILTER (WHERE f.link = ''),
+		  COUNT(*) FILTER (WHERE f.release_date IS NULL),
+		  COALESCE(ROUND(AVG(f.verses) FILTER (WHERE f.verses > 0), 2), 0)::float8
+		  FROM filtered f`
+	err = trx.QueryRow(ctx, qTotals, values...).Scan(&stats.Audios, &stats.WithoutLyrics,
+		&stats.WithoutLink, &stats.WithoutReleaseDate, &stats.AvgVerses)
+	if err != nil {
+		return nil, err
+	}
+
+	qGroups := filtered + `SELECT f."group", COUNT(*)
+		  FROM filtered f
+		  GROUP BY f."group"
+		  ORDER BY COUNT(*) DESC, f."group"`
+	rows, err := trx.Query(ctx, qGroups, values...)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		g := dto.GroupAudioStat{}
+		if err = rows.Scan(&g.Group, &g.Audios); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		stats.ByGroup = append(stats.ByGroup, g)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	qYears := filtered + `SELECT EXTRACT(YEAR FROM f.release_date)::int, COUNT(*)
+		  FROM filtered f
+		  WHERE f.release_date IS NOT NULL
+		  GROUP BY 1
+		  ORDER BY 1`
+	rows, err = trx.Query(ctx, qYears, values...)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		y := dto.YearAudioStat{}
+		if err = rows.Scan(&y.Year, &y.Audios); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		stats.ByYear = append(stats.ByYear, y)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &stats, trx.Commit(ctx)
+}
@@ -0,0 +1,75 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+)
+
+const chartColumns = `ce.position, ce.previous_position, ce.plays, ce.refreshed_at`
+
+type ChartCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewChartCRUD(c Client, l logging.Logger) *ChartCRUD {
+	return &ChartCRUD{db: c, logger: l}
+}
+
+// ListByPeriodPag
+// list chart of kind for period from last refreshed rollup,
+// top positions first
+func (c *ChartCRUD) ListByPeriodPag(ctx context.Context, period, kind string, pag Pagination) ([]dto.ChartEntryRead, error) {
+	var q string
+	if kind == dto.ChartByGroup {
+		q = `SELECT ` + artistColumns + `, ` + chartColumns + `
+		  FROM public.chart_entries ce
+		  JOIN public.artists ar ON ar.uuid = ce.subject_uuid
+		  WHERE ce.period = $1 AND ce.kind = $2
+		  ORDER BY ce.position, ar.name
+		  LIMIT $3 OFFSET $4`
+	} else {
+		q = `SELECT ` + audioColumns + `, ` + chartColumns + `
+		  FROM public.chart_entries ce
+		  JOIN public.audios a ON a.uuid = ce.subject_uuid
+		  WHERE ce.period = $1 AND ce.kind = $2
+		  ORDER BY ce.position, a.uuid
+		  LIMIT $3 OFFSET $4`
+	}
+
+	rows, err := c.db.Query(ctx, q, period, kind, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]dto.ChartEntryRead, 0, pag.Limit)
+	for rows.Next() {
+		e := dto.ChartEntryRead{}
+		if kind == dto.ChartByGroup {
+			ar := dto.ArtistRead{}
+			err = rows.Scan(&ar.UUID, &ar.Name, &ar.Country, &ar.Description, &ar.CreatedAt, &ar.UpdatedAt,
+				&e.Position, &e.PreviousPosition, &e.Plays, &e.RefreshedAt)
+			e.Artist = &ar
+		} else {
+			a := dto.AudioRead{}
+			err = scanAudio(rows, &a, &e.Position, &e.PreviousPosition, &e.Plays, &e.RefreshedAt)
+			e.Audio = &a
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// Refresh
+// recompute chart rollup from plays, charts stay readable meanwhile
+func (c *ChartCRUD) Refresh(ctx context.Context) error {
+	q := `REFRESH MATERIALIZED VIEW CONCURRENTLY public.chart_entries`
+
+	_, err := c.db.Exec(ctx, q)
+	return err
+}
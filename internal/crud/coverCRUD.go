@@ -0,0 +1,41 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errUnknownCoverTarget = errors.New("unknown cover target")
+
+type CoverCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewCoverCRUD(c Client, l logging.Logger) *CoverCRUD {
+	return &CoverCRUD{db: c, logger: l}
+}
+
+// SetKey
+// replace cover key of audio or album, target is one of dto.CoverTarget*.
+// Return previous key, pgx.ErrNoRows if row does not exist.
+// Invalid key removes cover
+func (c *CoverCRUD) SetKey(ctx context.Context, target string, uuid pgtype.UUID, key pgtype.Text) (pgtype.Text, error) {
+	if target != dto.CoverTargetAudio && target != dto.CoverTargetAlbum {
+		return pgtype.Text{}, errUnknownCoverTarget
+	}
+
+	q := fmt.Sprintf(`UPDATE public.%[1]s t
+		  SET cover_key = $2, updated_at = CURRENT_TIMESTAMP(3)
+		  FROM (SELECT uuid, cover_key FROM public.%[1]s WHERE uuid = $1 FOR UPDATE) old
+		  WHERE t.uuid = old.uuid
+		  RETURNING old.cover_key`, target)
+
+	old := pgtype.Text{}
+	err := c.db.QueryRow(ctx, q, uuid, key).Scan(&old)
+	return old, err
+}
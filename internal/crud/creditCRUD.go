@@ -0,0 +1,105 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/canon"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreditCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewCreditCRUD(c Client, l logging.Logger) *CreditCRUD {
+	return &CreditCRUD{db: c, logger: l}
+}
+
+// selectCredits
+// return audio credits ordered by role and person name
+func selectCredits(ctx context.Context, db Client, audioUUID pgtype.UUID) ([]dto.CreditRead, error) {
+	q := `SELECT c.uuid, c.audio_uuid, c.person_uuid, p.name, c.role, c.created_at
+		  FROM public.audio_credits c
+		  JOIN public.persons p ON p.uuid = c.person_uuid
+		  WHERE c.audio_uuid = $1
+		  ORDER BY c.role, p.name`
+
+	rows, err := db.Query(ctx, q, audioUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credits := make([]dto.CreditRead, 0)
+	for rows.Next() {
+		c := dto.CreditRead{}
+		err = rows.Scan(&c.UUID, &c.AudioUUID, &c.PersonUUID, &c.Name, &c.Role, &c.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		credits = append(credits, c)
+	}
+	return credits, rows.Err()
+}
+
+// upsertPersonByName
+// return uuid of person with the same canonical name, person is
+// created if not exists
+func upsertPersonByName(ctx context.Context, trx pgx.Tx, name string) (pgtype.UUID, error) {
+	q := `INSERT INTO public.persons
+		  (name, normalized_name, created_at, updated_at)
+		  VALUES ($1, $2, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (normalized_name) DO UPDATE SET normalized_name = EXCLUDED.normalized_name
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := trx.QueryRow(ctx, q, name, canon.ArtistName(name)).Scan(&uuid)
+	return uuid, err
+}
+
+func (c *CreditCRUD) ListByAudio(ctx context.Context, audioUUID pgtype.UUID) ([]dto.CreditRead, error) {
+	return selectCredits(ctx, c.db, audioUUID)
+}
+
+// Create
+// credit person to audio, person is created if not exists
+func (c *CreditCRUD) Create(ctx context.Context, audioUUID pgtype.UUID, credit *dto.CreditCreate) (pgtype.UUID, error) {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	defer trx.Rollback(ctx)
+
+	personUUID, err := upsertPersonByName(ctx, trx, credit.Name)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+
+	q := `INSERT INTO public.audio_credits
+		  (audio_uuid, person_uuid, role, created_at)
+		  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3))
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err = trx.QueryRow(ctx, q, audioUUID, personUUID, credit.Role).Scan(&uuid)
+	if err != nil {
+		return pgtype.UUID{}, mapPgError(err)
+	}
+	return uuid, trx.Commit(ctx)
+}
+
+func (c *CreditCRUD) Delete(ctx context.Context, audioUUID, creditUUID pgtype.UUID) error {
+	q := `DELETE FROM public.audio_credits WHERE uuid = $1 AND audio_uuid = $2`
+
+	tag, err := c.db.Exec(ctx, q, creditUUID, audioUUID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
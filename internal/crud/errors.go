@@ -0,0 +1,29 @@
+package crud
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	ErrUniqueViolation     = errors.New("already exists")
+	ErrForeignKeyViolation = errors.New("referenced by other rows")
+)
+
+// mapPgError
+// wrap postgres constraint errors in package errors
+func mapPgError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case "23505":
+		return fmt.Errorf("%w: %s", ErrUniqueViolation, pgErr.Detail)
+	case "23503":
+		return fmt.Errorf("%w: %s", ErrForeignKeyViolation, pgErr.Detail)
+	}
+	return err
+}
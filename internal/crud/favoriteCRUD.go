@@ -0,0 +1,83 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type FavoriteCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewFavoriteCRUD(c Client, l logging.Logger) *FavoriteCRUD {
+	return &FavoriteCRUD{db: c, logger: l}
+}
+
+// Add
+// mark audio as favorite of user, already favorite audio is kept as is
+func (c *FavoriteCRUD) Add(ctx context.Context, userID string, audioUUID pgtype.UUID) error {
+	q := `INSERT INTO public.favorites
+		  (user_id, audio_uuid, created_at)
+		  VALUES ($1, $2, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (user_id, audio_uuid) DO NOTHING`
+
+	_, err := c.db.Exec(ctx, q, userID, audioUUID)
+	return mapPgError(err)
+}
+
+func (c *FavoriteCRUD) Remove(ctx context.Context, userID string, audioUUID pgtype.UUID) error {
+	q := `DELETE FROM public.favorites WHERE user_id = $1 AND audio_uuid = $2`
+
+	tag, err := c.db.Exec(ctx, q, userID, audioUUID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// ListByUserPag
+// list favorite audios of user, recently liked first
+func (c *FavoriteCRUD) ListByUserPag(ctx context.Context, userID string, pag Pagination) ([]dto.FavoriteRead, error) {
+	q := `SELECT ` + audioColumns + `, f.created_at
+		  FROM public.favorites f
+		  JOIN public.audios a ON a.uuid = f.audio_uuid
+		  WHERE f.user_id = $1
+		  ORDER BY f.created_at DESC, f.audio_uuid
+		  LIMIT $2 OFFSET $3`
+
+	rows, err := c.db.Query(ctx, q, userID, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	favorites := make([]dto.FavoriteRead, 0, pag.Limit)
+	for rows.Next() {
+		f := dto.FavoriteRead{}
+		err = scanAudio(rows, &f.AudioRead, &f.FavoritedAt)
+		if err != nil {
+			return nil, err
+		}
+		favorites = append(favorites, f)
+	}
+	return favorites, rows.Err()
+}
+
+// FilterAudios
+// return audios of given which are favorites of user
+func (c *FavoriteCRUD) FilterAudios(ctx context.Context, userID string, audioUUIDs []pgtype.UUID) ([]pgtype.UUID, error) {
+	q := `SELECT audio_uuid FROM public.favorites WHERE user_id = $1 AND audio_uuid = ANY($2)`
+
+	rows, err := c.db.Query(ctx, q, userID, audioUUIDs)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+}
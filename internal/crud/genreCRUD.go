@@ -0,0 +1,148 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const genreColumns = `g.uuid, g.parent_uuid, g.name, g.slug, g.created_at, g.updated_at`
+
+type GenreCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewGenreCRUD(c Client, l logging.Logger) *GenreCRUD {
+	return &GenreCRUD{db: c, logger: l}
+}
+
+// scanGenre
+// scan row selected with genreColumns
+func scanGenre(row pgx.Row, g *dto.GenreRead) error {
+	return row.Scan(&g.UUID, &g.ParentUUID, &g.Name, &g.Slug, &g.CreatedAt, &g.UpdatedAt)
+}
+
+// selectAudioGenres
+// return genres attached to audio ordered by name
+func selectAudioGenres(ctx context.Context, db Client, audioUUID pgtype.UUID) ([]dto.GenreRead, error) {
+	q := `SELECT ` + genreColumns + `
+		  FROM public.audio_genres ag
+		  JOIN public.genres g ON g.uuid = ag.genre_uuid
+		  WHERE ag.audio_uuid = $1
+		  ORDER BY g.name`
+
+	rows, err := db.Query(ctx, q, audioUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	genres := make([]dto.GenreRead, 0)
+	for rows.Next() {
+		g := dto.GenreRead{}
+		if err = scanGenre(rows, &g); err != nil {
+			return nil, err
+		}
+		genres = append(genres, g)
+	}
+	return genres, rows.Err()
+}
+
+// genreCondition
+// return condition matching audios with any of genres given by slugs
+// placeholder or their descendants
+func genreCondition(placeholder string) string {
+	return `EXISTS (WITH RECURSIVE sub AS (
+			  SELECT uuid FROM public.genres WHERE slug = ANY(` + placeholder + `)
+			  UNION
+			  SELECT ch.uuid FROM public.genres ch JOIN sub ON ch.parent_uuid = sub.uuid)
+			  SELECT 1 FROM public.audio_genres ag
+			  WHERE ag.audio_uuid = a.uuid AND ag.genre_uuid IN (SELECT uuid FROM sub))`
+}
+
+func (c *GenreCRUD) Create(ctx context.Context, genre *dto.GenreCreate) (pgtype.UUID, error) {
+	q := `INSERT INTO public.genres
+		  (parent_uuid, name, slug, created_at, updated_at)
+		  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := c.db.QueryRow(ctx, q, genre.ParentUUID, genre.Name, genre.Slug).Scan(&uuid)
+	return uuid, mapPgError(err)
+}
+
+func (c *GenreCRUD) FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.GenreRead, error) {
+	q := `SELECT ` + genreColumns + `
+		  FROM public.genres g
+		  WHERE g.uuid = $1`
+
+	g := dto.GenreRead{}
+	err := scanGenre(c.db.QueryRow(ctx, q, uuid), &g)
+	return &g, err
+}
+
+func (c *GenreCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.GenreRead, error) {
+	q := `SELECT ` + genreColumns + `
+		  FROM public.genres g
+		  ORDER BY g.name
+		  LIMIT $1 OFFSET $2`
+
+	rows, err := c.db.Query(ctx, q, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	genres := make([]dto.GenreRead, 0, pag.Limit)
+	for rows.Next() {
+		g := dto.GenreRead{}
+		if err = scanGenre(rows, &g); err != nil {
+			return nil, err
+		}
+		genres = append(genres, g)
+	}
+	return genres, rows.Err()
+}
+
+// Delete
+// delete genre without subgenres, audios lose the genre
+func (c *GenreCRUD) Delete(ctx context.Context, uuid pgtype.UUID) error {
+	q := `DELETE FROM public.genres WHERE uuid = $1`
+
+	tag, err := c.db.Exec(ctx, q, uuid)
+	if err != nil {
+		return mapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// Attach
+// attach genre to audio, attaching twice is not an error
+func (c *GenreCRUD) Attach(ctx context.Context, audioUUID, genreUUID pgtype.UUID) error {
+	q := `INSERT INTO public.audio_genres
+		  (audio_uuid, genre_uuid, created_at)
+		  VALUES ($1, $2, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT DO NOTHING`
+
+	_, err := c.db.Exec(ctx, q, audioUUID, genreUUID)
+	return mapPgError(err)
+}
+
+func (c *GenreCRUD) Detach(ctx context.Context, audioUUID, genreUUID pgtype.UUID) error {
+	q := `DELETE FROM public.audio_genres WHERE audio_uuid = $1 AND genre_uuid = $2`
+
+	tag, err := c.db.Exec(ctx, q, audioUUID, genreUUID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+)
+
+type InfoCacheCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewInfoCacheCRUD(c Client, l logging.Logger) *InfoCacheCRUD {
+	return &InfoCacheCRUD{db: c, logger: l}
+}
+
+// Find
+// return not expired entry by key or pgx.ErrNoRows
+func (c *InfoCacheCRUD) Find(ctx context.Context, key string) (*dto.InfoCacheEntry, error) {
+	q := `SELECT key, release_date, release_date_precision, text, link, not_found, expires_at
+		  FROM public.info_cache
+		  WHERE key = $1 AND expires_at > CURRENT_TIMESTAMP`
+
+	e := dto.InfoCacheEntry{}
+	err := c.db.QueryRow(ctx, q, key).Scan(&e.Key, &e.ReleaseDate, &e.ReleaseDatePrecision, &e.Text, &e.Link, &e.NotFound, &e.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Upsert
+// insert entry or replace existing one with the same key
+func (c *InfoCacheCRUD) Upsert(ctx context.Context, entry *dto.InfoCacheEntry) error {
+	q := `INSERT INTO public.info_cache
+		  (key, release_date, release_date_precision, text, link, not_found, expires_at, created_at)
+		  VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (key) DO UPDATE
+		  SET release_date = EXCLUDED.release_date,
+		      release_date_precision = EXCLUDED.release_date_precision,
+		      text = EXCLUDED.text,
+		      link = EXCLUDED.link,
+		      not_found = EXCLUDED.not_found,
+		      expires_at = EXCLUDED.expires_at,
+		      created_at = EXCLUDED.created_at`
+
+	_, err := c.db.Exec(ctx, q, entry.Key, entry.ReleaseDate, releaseDatePrecision(entry.ReleaseDatePrecision), entry.Text, entry.Link, entry.NotFound, entry.ExpiresAt)
+	return err
+}
+
+// DeleteExpired
+// remove all expired entries
+func (c *InfoCacheCRUD) DeleteExpired(ctx context.Context) error {
+	q := `DELETE FROM public.info_cache WHERE expires_at <= CURRENT_TIMESTAMP`
+	_, err := c.db.Exec(ctx, q)
+	return err
+}
@@ -0,0 +1,358 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/links"
+	"eMobile/pkg/logging"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const linkColumns = `li.uuid, li.audio_uuid, li.platform, li.url, COALESCE(li.external_id, ''), li.is_primary, li.created_at`
+
+type LinkCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewLinkCRUD(c Client, l logging.Logger) *LinkCRUD {
+	return &LinkCRUD{db: c, logger: l}
+}
+
+// selectLinks
+// return audio links, primary first
+func selectLinks(ctx context.Context, db Client, audioUUID pgtype.UUID) ([]dto.LinkRead, error) {
+	q := `SELECT ` + linkColumns + `
+		  FROM public.audio_links li
+		  WHERE li.audio_uuid = $1
+		  ORDER BY li.is_primary DESC, li.created_at`
+
+	rows, err := db.Query(ctx, q, audioUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	audioLinks := make([]dto.LinkRead, 0)
+	for rows.Next() {
+		l := dto.LinkRead{}
+		err = rows.Scan(&l.UUID, &l.AudioUUID, &l.Platform, &l.URL, &l.ExternalID, &l.IsPrimary, &l.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		audioLinks = append(audioLinks, l)
+	}
+	return audioLinks, rows.Err()
+}
+
+// setPrimaryLink
+// make canonical url primary link of audio, link is created if not exists.
+// Source is origin of url. Empty url only unsets current primary link
+func setPrimaryLink(ctx context.Context, trx pgx.Tx, audioUUID pgtype.UUID, url, source string) error {
+	qUnset := `UPDATE public.audio_links
+		  SET is_primary = FALSE
+		  WHERE audio_uuid = $1 AND is_primary AND url <> $2`
+	if _, err := trx.Exec(ctx, qUnset, audioUUID, url); err != nil {
+		return err
+	}
+	if url == "" {
+		return nil
+	}
+
+	link, err := links.Parse(url)
+	if err != nil {
+		link = links.Link{Platform: links.Platform(url)}
+	}
+
+	qLink := `INSERT INTO public.audio_links
+		  (audio_uuid, platform, url, external_id, is_primary, source, created_at)
+		  VALUES ($1, $2, $3, $4, TRUE, $5, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (audio_uuid, url) DO UPDATE
+		  SET is_primary = TRUE,
+		      platform = EXCLUDED.platform,
+		      external_id = EXCLUDED.external_id,
+		      source = EXCLUDED.source`
+	_, err = trx.Exec(ctx, qLink, audioUUID, link.Platform, url, link.ExternalID, source)
+	return err
+}
+
+// linkSource
+// return source of link field in provenance, manual if it is not there
+func linkSource(provenance []dto.ProvenanceCreate) string {
+	for _, p := range provenance {
+		if p.Field == dto.FieldLink {
+			return p.Source
+		}
+	}
+	return dto.SourceManual
+}
+
+// syncAudioLink
+// copy primary link url to audios.link, empty if audio has no links.
+// Provenance of link is source of primary link
+func syncAudioLink(ctx context.Context, trx pgx.Tx, audioUUID pgtype.UUID, source, editor string) error {
+	q := `UPDATE public.audios
+		  SET link = COALESCE((SELECT url FROM public.audio_links WHERE audio_uuid = $1 AND is_primary), ''),
+		      updated_at = CURRENT_TIMESTAMP(3)
+		  WHERE uuid = $1`
+	if _, err := trx.Exec(ctx, q, audioUUID); err != nil {
+		return err
+	}
+
+	return upsertProvenance(ctx, trx, audioUUID, []dto.ProvenanceCreate{{
+		Field:  dto.FieldLink,
+		Source: source,
+		Editor: editor,
+	}})
+}
+
+func (c *LinkCRUD) ListByAudio(ctx context.Context, audioUUID pgtype.UUID) ([]dto.LinkRead, error) {
+	return selectLinks(ctx, c.db, audioUUID)
+}
+
+// Create
+// add link to audio. First link of audio is always primary
+func (c *LinkCRUD) Create(ctx context.Context, audioUUID pgtype.UUID, link *dto.LinkCreate) (pgtype.UUID, error) {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	defer trx.Rollback(ctx)
+
+	if link.IsPrimary {
+		qUnset := `UPDATE public.audio_links SET is_primary = FALSE WHERE audio_uuid = $1 AND is_primary`
+		if _, err = trx.Exec(ctx, qUnset, audioUUID); err != nil {
+			return pgtype.UUID{}, err
+		}
+	}
+
+	q := `INSERT INTO public.audio_links
+		  (audio_uuid, platform, url, external_id, is_primary, source, created_at)
+		  VALUES ($1, $2, $3, $4,
+		          $5 OR NOT EXISTS (SELECT 1 FROM public.audio_links WHERE audio_uuid = $1 AND is_primary),
+		          $6, CURRENT_TIMESTAMP(3))
+		  RETURNING uuid, is_primary`
+
+	uuid := pgtype.UUID{}
+	isPrimary := false
+	err = trx.QueryRow(ctx, q, audioUUID, link.Platform, link.URL, link.ExternalID, link.IsPrimary, dto.SourceManual).Scan(&uuid, &isPrimary)
+	if err != nil {
+		return pgtype.UUID{}, mapPgError(err)
+	}
+
+	if isPrimary {
+		if err = syncAudioLink(ctx, trx, audioUUID, dto.SourceManual, link.Editor); err != nil {
+			return pgtype.UUID{}, err
+		}
+	}
+	return uuid, trx.Commit(ctx)
+}
+
+// SetPrimary
+// make link primary and copy its url to audios.link
+func (c *LinkCRUD) SetPrimary(ctx context.Context, audioUUID, linkUUID pgtype.UUID, editor string) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	qUnset := `UPDATE public.audio_links SET is_primary = FALSE WHERE audio_uuid = $1 AND is_primary`
+	if _, err = trx.Exec(ctx, qUnset, audioUUID); err != nil {
+		return err
+	}
+
+	qSet := `UPDATE public.audio_links SET is_primary = TRUE WHERE uuid = $1 AND audio_uuid = $2`
+	tag, err := trx.Exec(ctx, qSet, linkUUID, audioUUID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err = syncAudioLink(ctx, trx, audioUUID, dto.SourceManual, editor); err != nil {
+		return err
+	}
+	return trx.Commit(ctx)
+}
+
+// Delete
+// delete link, if it was primary the oldest remaining link becomes primary
+func (c *LinkCRUD) Delete(ctx context.Context, audioUUID, linkUUID pgtype.UUID, editor string) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	q := `DELETE FROM public.audio_links
+		  WHERE uuid = $1 AND audio_uuid = $2
+		  RETURNING is_primary`
+
+	wasPrimary := false
+	if err = trx.QueryRow(ctx, q, linkUUID, audioUUID).Scan(&wasPrimary); err != nil {
+		return err
+	}
+
+	if wasPrimary {
+		qPromote := `UPDATE public.audio_links
+			  SET is_primary = TRUE
+			  WHERE uuid = (SELECT uuid FROM public.audio_links
+			                WHERE audio_uuid = $1
+			                ORDER BY created_at
+			                LIMIT 1)
+			  RETURNING source`
+		// promoted link keeps its source, no link left is manual removal
+		source := dto.SourceManual
+		err = trx.QueryRow(ctx, qPromote, audioUUID).Scan(&source)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if err = syncAudioLink(ctx, trx, audioUUID, source, editor); err != nil {
+			return err
+		}
+	}
+	return trx.Commit(ctx)
+}
+
+// CanonicalizeURLs
+// create primary links of audios stored before links table, replace urls
+// of links not canonicalized yet with canonical form and fill external ids.
+// Links which become duplicates of existing link are deleted.
+// Return number of processed links
+func (c *LinkCRUD) CanonicalizeURLs(ctx context.Context) (int, error) {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer trx.Rollback(ctx)
+
+	created, err := fillAudioLinks(ctx, trx)
+	if err != nil {
+		return 0, err
+	}
+
+	q := `SELECT uuid, audio_uuid, url, is_primary
+		  FROM public.audio_links
+		  WHERE external_id IS NULL
+		  ORDER BY is_primary DESC, created_at`
+
+	rows, err := trx.Query(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+	type link struct {
+		uuid      pgtype.UUID
+		audioUUID pgtype.UUID
+		url       string
+		isPrimary bool
+	}
+	var audioLinks []link
+	for rows.Next() {
+		l := link{}
+		if err = rows.Scan(&l.uuid, &l.audioUUID, &l.url, &l.isPrimary); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		audioLinks = append(audioLinks, l)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+	if created == 0 && len(audioLinks) == 0 {
+		return 0, nil
+	}
+
+	qDuplicate := `SELECT uuid FROM public.audio_links WHERE audio_uuid = $1 AND url = $2 AND uuid <> $3`
+	qDelete := `DELETE FROM public.audio_links WHERE uuid = $1`
+	qPromote := `UPDATE public.audio_links SET is_primary = TRUE WHERE uuid = $1`
+	qUpdate := `UPDATE public.audio_links SET url = $2, platform = $3, external_id = $4 WHERE uuid = $1`
+	for _, l := range audioLinks {
+		canonical, err := links.Parse(l.url)
+		if err != nil {
+			// invalid urls are kept as is
+			canonical = links.Link{URL: l.url, Platform: links.Platform(l.url)}
+		}
+
+		duplicate := pgtype.UUID{}
+		err = trx.QueryRow(ctx, qDuplicate, l.audioUUID, canonical.URL, l.uuid).Scan(&duplicate)
+		switch {
+		case err == nil:
+			if _, err = trx.Exec(ctx, qDelete, l.uuid); err != nil {
+				return 0, err
+			}
+			if l.isPrimary {
+				if _, err = trx.Exec(ctx, qPromote, duplicate); err != nil {
+					return 0, err
+				}
+			}
+		case errors.Is(err, pgx.ErrNoRows):
+			_, err = trx.Exec(ctx, qUpdate, l.uuid, canonical.URL, canonical.Platform, canonical.ExternalID)
+			if err != nil {
+				return 0, err
+			}
+		default:
+			return 0, err
+		}
+	}
+
+	// audios.link is a copy of primary link
+	qSync := `UPDATE public.audios a
+		  SET link = li.url
+		  FROM public.audio_links li
+		  WHERE li.audio_uuid = a.uuid AND li.is_primary AND a.link <> li.url`
+	if _, err = trx.Exec(ctx, qSync); err != nil {
+		return 0, err
+	}
+	return created + len(audioLinks), trx.Commit(ctx)
+}
+
+// fillAudioLinks
+// create canonical primary link of every audio with link but without
+// links, source of link is taken from provenance. Return number of
+// created links
+func fillAudioLinks(ctx context.Context, trx pgx.Tx) (int, error) {
+	q := `SELECT a.uuid, a.link, COALESCE(p.source, a.source)
+		  FROM public.audios a
+		  LEFT JOIN public.audio_provenance p ON p.audio_uuid = a.uuid AND p.field = $1
+		  WHERE a.link <> '' AND NOT EXISTS (
+		      SELECT 1 FROM public.audio_links li WHERE li.audio_uuid = a.uuid AND li.is_primary)`
+
+	rows, err := trx.Query(ctx, q, dto.FieldLink)
+	if err != nil {
+		return 0, err
+	}
+	type audioLink struct {
+		audioUUID pgtype.UUID
+		url       string
+		source    string
+	}
+	var audioLinks []audioLink
+	for rows.Next() {
+		l := audioLink{}
+		if err = rows.Scan(&l.audioUUID, &l.url, &l.source); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		audioLinks = append(audioLinks, l)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, l := range audioLinks {
+		url := l.url
+		if canonical, err := links.Parse(l.url); err == nil {
+			url = canonical.URL
+		}
+		if err = setPrimaryLink(ctx, trx, l.audioUUID, url, l.source); err != nil {
+			return 0, err
+		}
+	}
+	return len(audioLinks), nil
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"eMobile/internal/dto"
 	"eMobile/pkg/logging"
+	"errors"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// lyricColumns
+// verses not classified yet are not explicit
+const lyricColumns = `uuid, audio_uuid, "order", text, COALESCE(explicit, FALSE), created_at, updated_at`
+
 type LyricCRUD struct {
 	c Client
 	l logging.Logger
@@ -17,8 +22,14 @@ func NewLyricCRUD(c Client, l logging.Logger) *LyricCRUD {
 	return &LyricCRUD{c: c, l: l}
 }
 
+// scanLyric
+// scan row selected with lyricColumns
+func scanLyric(row pgx.Row, l *dto.LyricRead) error {
+	return row.Scan(&l.UUID, &l.AudioUUID, &l.Order, &l.Text, &l.Explicit, &l.CreatedAt, &l.UpdatedAt)
+}
+
 func (c *LyricCRUD) ListByAudioPag(ctx context.Context, audioUUID pgtype.UUID, pag Pagination) ([]dto.LyricRead, error) {
-	q := `SELECT uuid, audio_uuid, "order", text, created_at, updated_at
+	q := `SELECT ` + lyricColumns + `
 		  FROM public.lyrics
 		  WHERE audio_uuid = $1
 		  ORDER BY "order"
@@ -32,7 +43,7 @@ func (c *LyricCRUD) ListByAudioPag(ctx context.Context, audioUUID pgtype.UUID, p
 	}
 	for rows.Next() {
 		lyric := dto.LyricRead{}
-		err = rows.Scan(&lyric.UUID, &lyric.AudioUUID, &lyric.Order, &lyric.Text, &lyric.CreatedAt, &lyric.UpdatedAt)
+		err = scanLyric(rows, &lyric)
 		if err != nil {
 			return nil, err
 		}
@@ -46,11 +57,11 @@ func (c *LyricCRUD) ListByAudioPag(ctx context.Context, audioUUID pgtype.UUID, p
 }
 
 func (c *LyricCRUD) FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.LyricRead, error) {
-	q := `SELECT uuid, audio_uuid, "order", text, created_at, updated_at
+	q := `SELECT ` + lyricColumns + `
 		  FROM public.lyrics
 		  WHERE uuid = $1`
 	lyric := &dto.LyricRead{}
-	err := c.c.QueryRow(ctx, q, uuid).Scan(lyric.UUID, lyric.AudioUUID, lyric.Order, lyric.Text, lyric.CreatedAt, lyric.UpdatedAt)
+	err := scanLyric(c.c.QueryRow(ctx, q, uuid), lyric)
 
 	return lyric, err
 }
@@ -66,3 +77,131 @@ func (c *LyricCRUD) DeleteAllByAudio(ctx context.Context, uuid pgtype.UUID) erro
 	}
 	return nil
 }
+
+// ExplicitFingerprint
+// return fingerprint of classifier stored verses are classified with,
+// empty if verses were never classified
+func (c *LyricCRUD) ExplicitFingerprint(ctx context.Context) (string, error) {
+	q := `SELECT fingerprint FROM public.lyrics_classifier`
+
+	fingerprint := ""
+	err := c.c.QueryRow(ctx, q).Scan(&fingerprint)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return fingerprint, err
+}
+
+// ResetExplicit
+// mark all verses not classified and store fingerprint of classifier
+// they are going to be classified with
+func (c *LyricCRUD) ResetExplicit(ctx context.Context, fingerprint string) error {
+	trx, err := c.c.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	q := `UPDATE public.lyrics SET explicit = NULL WHERE explicit IS NOT NULL`
+	if _, err = trx.Exec(ctx, q); err != nil {
+		return err
+	}
+
+	qFingerprint := `INSERT INTO public.lyrics_classifier (fingerprint, updated_at)
+		  VALUES ($1, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (id) DO UPDATE
+		  SET fingerprint = EXCLUDED.fingerprint,
+		      updated_at = EXCLUDED.updated_at`
+	if _, err = trx.Exec(ctx, qFingerprint, fingerprint); err != nil {
+		return err
+	}
+	return trx.Commit(ctx)
+}
+
+// FillExplicit
+// classify up to limit verses not classified yet in one transaction.
+// Audios of classified verses are updated in the same transaction unless
+// their flag was set manually: audio with explicit verse is flagged, flag
+// set by classifier is cleared when all verses of audio are clean.
+// Updated audios get classifier provenance. Stopping between batches
+// never leaves explicit verse of not flagged audio. Return number of
+// classified verses
+func (c *LyricCRUD) FillExplicit(ctx context.Context, isExplicit func(text string) bool, limit int) (int, error) {
+	trx, err := c.c.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer trx.Rollback(ctx)
+
+	q := `SELECT uuid, text FROM public.lyrics
+		  WHERE explicit IS NULL
+		  LIMIT $1
+		  FOR UPDATE SKIP LOCKED`
+
+	rows, err := trx.Query(ctx, q, limit)
+	if err != nil {
+		return 0, err
+	}
+	var uuids []pgtype.UUID
+	var flags []bool
+	for rows.Next() {
+		uuid, text := pgtype.UUID{}, ""
+		if err = rows.Scan(&uuid, &text); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		uuids = append(uuids, uuid)
+		flags = append(flags, isExplicit(text))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(uuids) == 0 {
+		return 0, nil
+	}
+
+	qUpdate := `UPDATE public.lyrics l SET explicit = v.explicit
+		  FROM unnest($1::uuid[], $2::boolean[]) AS v(uuid, explicit)
+		  WHERE l.uuid = v.uuid`
+	if _, err = trx.Exec(ctx, qUpdate, uuids, flags); err != nil {
+		return 0, err
+	}
+
+	qAudios := `UPDATE public.audios a
+		  SET explicit = EXISTS (
+		          SELECT 1 FROM public.lyrics l WHERE l.audio_uuid = a.uuid AND l.explicit),
+		      updated_at = CURRENT_TIMESTAMP(3)
+		  WHERE a.uuid IN (SELECT audio_uuid FROM public.lyrics WHERE uuid = ANY($1))
+		  AND NOT EXISTS (
+		      SELECT 1 FROM public.audio_provenance p
+		      WHERE p.audio_uuid = a.uuid AND p.field = $2 AND p.source = $3)
+		  AND (
+		      -- flag explicit audio
+		      (NOT a.explicit AND EXISTS (
+		          SELECT 1 FROM public.lyrics l WHERE l.audio_uuid = a.uuid AND l.explicit))
+		      -- clear flag set by classifier when every verse is classified clean
+		      OR (a.explicit AND EXISTS (
+		          SELECT 1 FROM public.audio_provenance p
+		          WHERE p.audio_uuid = a.uuid AND p.field = $2 AND p.source = $4)
+		        AND NOT EXISTS (
+		          SELECT 1 FROM public.lyrics l WHERE l.audio_uuid = a.uuid AND l.explicit IS NOT FALSE)))
+		  RETURNING a.uuid`
+	rows, err = trx.Query(ctx, qAudios, uuids, dto.FieldExplicit, dto.SourceManual, dto.SourceClassifier)
+	if err != nil {
+		return 0, err
+	}
+	audios, err := pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+	if err != nil {
+		return 0, err
+	}
+
+	err = upsertAudiosProvenance(ctx, trx, audios, dto.ProvenanceCreate{
+		Field:  dto.FieldExplicit,
+		Source: dto.SourceClassifier,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(uuids), trx.Commit(ctx)
+}
@@ -0,0 +1,107 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+const playColumns = `p.uuid, p.user_id, p.audio_uuid, p.played_at, p.duration_ms, p.created_at`
+
+type PlayCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewPlayCRUD(c Client, l logging.Logger) *PlayCRUD {
+	return &PlayCRUD{db: c, logger: l}
+}
+
+// scanPlay
+// scan row selected with playColumns
+func scanPlay(row pgx.Row, p *dto.PlayRead) error {
+	return row.Scan(&p.UUID, &p.UserID, &p.AudioUUID, &p.PlayedAt, &p.DurationMs, &p.CreatedAt)
+}
+
+// Create
+// record play and count it in audio play count. If user played audio
+// within window of play or recorded a play of it within window of
+// server time, existing play is returned and false
+func (c *PlayCRUD) Create(ctx context.Context, audioUUID pgtype.UUID, play *dto.PlayCreate, window time.Duration) (*dto.PlayRead, bool, error) {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer trx.Rollback(ctx)
+
+	// audio row lock serializes plays of audio, so concurrent
+	// duplicates are not both recorded
+	qAudio := `SELECT uuid FROM public.audios WHERE uuid = $1 FOR UPDATE`
+	if err = trx.QueryRow(ctx, qAudio, audioUUID).Scan(&audioUUID); err != nil {
+		return nil, false, err
+	}
+
+	qRecent := `SELECT ` + playColumns + `
+		  FROM public.plays p
+		  WHERE p.user_id = $1 AND p.audio_uuid = $2
+		    AND ((p.played_at > $3::timestamptz - $4 * INTERVAL '1 millisecond'
+		      AND p.played_at < $3::timestamptz + $4 * INTERVAL '1 millisecond')
+		      OR p.created_at > CURRENT_TIMESTAMP(3) - $4 * INTERVAL '1 millisecond')
+		  ORDER BY p.played_at DESC
+		  LIMIT 1`
+	p := dto.PlayRead{}
+	err = scanPlay(trx.QueryRow(ctx, qRecent, play.UserID, audioUUID, play.PlayedAt, window.Milliseconds()), &p)
+	if err == nil {
+		return &p, false, nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	qPlay := `INSERT INTO public.plays AS p
+		  (user_id, audio_uuid, played_at, duration_ms, created_at)
+		  VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP(3))
+		  RETURNING ` + playColumns
+	err = scanPlay(trx.QueryRow(ctx, qPlay, play.UserID, audioUUID, play.PlayedAt, play.DurationMs), &p)
+	if err != nil {
+		return nil, false, mapPgError(err)
+	}
+
+	qCount := `UPDATE public.audios SET play_count = play_count + 1 WHERE uuid = $1`
+	if _, err = trx.Exec(ctx, qCount, audioUUID); err != nil {
+		return nil, false, err
+	}
+
+	return &p, true, mapPgError(trx.Commit(ctx))
+}
+
+// ListHistoryByUserPag
+// list audios played by user, recent plays first
+func (c *PlayCRUD) ListHistoryByUserPag(ctx context.Context, userID string, pag Pagination) ([]dto.PlayHistoryRead, error) {
+	q := `SELECT ` + audioColumns + `, p.uuid, p.played_at, p.duration_ms
+		  FROM public.plays p
+		  JOIN public.audios a ON a.uuid = p.audio_uuid
+		  WHERE p.user_id = $1
+		  ORDER BY p.played_at DESC, p.uuid
+		  LIMIT $2 OFFSET $3`
+
+	rows, err := c.db.Query(ctx, q, userID, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]dto.PlayHistoryRead, 0, pag.Limit)
+	for rows.Next() {
+		h := dto.PlayHistoryRead{}
+		err = scanAudio(rows, &h.AudioRead, &h.PlayUUID, &h.PlayedAt, &h.PlayDurationMs)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, h)
+	}
+	return history, rows.Err()
+}
@@ -0,0 +1,337 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strconv"
+	"strings"
+)
+
+// playlistColumns
+// columns selected for dto.PlaylistRead, table must be aliased as "p"
+const playlistColumns = `p.uuid, p.owner, p.name, p.description,
+	(SELECT COUNT(*) FROM public.playlist_entries pe WHERE pe.playlist_uuid = p.uuid), p.created_at, p.updated_at`
+
+type PlaylistCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewPlaylistCRUD(c Client, l logging.Logger) *PlaylistCRUD {
+	return &PlaylistCRUD{db: c, logger: l}
+}
+
+// scanPlaylist
+// scan row selected with playlistColumns
+func scanPlaylist(row pgx.Row, p *dto.PlaylistRead) error {
+	return row.Scan(&p.UUID, &p.Owner, &p.Name, &p.Description, &p.EntryCount, &p.CreatedAt, &p.UpdatedAt)
+}
+
+func (c *PlaylistCRUD) Create(ctx context.Context, playlist *dto.PlaylistCreate) (pgtype.UUID, error) {
+	q := `INSERT INTO public.playlists
+		  (owner, name, description, created_at, updated_at)
+		  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3), CURRENT_TIMESTAMP(3))
+		  RETURNING uuid`
+
+	uuid := pgtype.UUID{}
+	err := c.db.QueryRow(ctx, q, playlist.Owner, playlist.Name, playlist.Description).Scan(&uuid)
+	return uuid, mapPgError(err)
+}
+
+func (c *PlaylistCRUD) FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.PlaylistRead, error) {
+	q := `SELECT ` + playlistColumns + `
+		  FROM public.playlists p
+		  WHERE p.uuid = $1`
+
+	p := dto.PlaylistRead{}
+	err := scanPlaylist(c.db.QueryRow(ctx, q, uuid), &p)
+	return &p, err
+}
+
+// FindByUUIDWithEntries
+// return playlist with entries ordered by position
+func (c *PlaylistCRUD) FindByUUIDWithEntries(ctx context.Context, uuid pgtype.UUID) (*dto.PlaylistReadFull, error) {
+	qPlaylist := `SELECT ` + playlistColumns + `
+		  FROM public.playlists p
+		  WHERE p.uuid = $1`
+
+	p := dto.PlaylistReadFull{}
+	err := scanPlaylist(c.db.QueryRow(ctx, qPlaylist, uuid), &p.PlaylistRead)
+	if err != nil {
+		return nil, err
+	}
+
+	qEntries := `SELECT ` + audioColumns + `, e.uuid, e.position, e.created_at
+		  FROM public.playlist_entries e
+		  JOIN public.audios a ON a.uuid = e.audio_uuid
+		  WHERE e.playlist_uuid = $1
+		  ORDER BY e.position`
+
+	rows, err := c.db.Query(ctx, qEntries, uuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]dto.PlaylistEntryRead, 0)
+	for rows.Next() {
+		e := dto.PlaylistEntryRead{}
+		err = scanAudio(rows, &e.AudioRead, &e.EntryUUID, &e.Position, &e.AddedAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	p.Entries = entries
+
+	return &p, rows.Err()
+}
+
+// ListByOwnerPag
+// list playlists of owner, all playlists if owner is empty
+func (c *PlaylistCRUD) ListByOwnerPag(ctx context.Context, owner string, pag Pagination) ([]dto.PlaylistRead, error) {
+	q := `SELECT ` + playlistColumns + `
+		  FROM public.playlists p
+		  WHERE $1 = '' OR p.owner = $1
+		  ORDER BY p.updated_at DESC, p.uuid
+		  LIMIT $2 OFFSET $3`
+
+	rows, err := c.db.Query(ctx, q, owner, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	playlists := make([]dto.PlaylistRead, 0, pag.Limit)
+	for rows.Next() {
+		p := dto.PlaylistRead{}
+		err = scanPlaylist(rows, &p)
+		if err != nil {
+			return nil, err
+		}
+		playlists = append(playlists, p)
+	}
+	return playlists, rows.Err()
+}
+
+func (c *PlaylistCRUD) Update(ctx context.Context, uuid pgtype.UUID, playlist *dto.PlaylistUpdate) (*dto.PlaylistRead, error) {
+	names := []string{"updated_at"}
+	ids := []string{"CURRENT_TIMESTAMP(3)"}
+	values := []any{uuid}
+	count := 2
+
+	if playlist.Name.Valid {
+		names = append(names, "name")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, playlist.Name.String)
+		count++
+	}
+	if playlist.Description.Valid {
+		names = append(names, "description")
+		ids = append(ids, "$"+strconv.Itoa(count))
+		values = append(values, playlist.Description.String)
+		count++
+	}
+
+	q := fmt.Sprintf(`UPDATE public.playlists p
+		  SET (%s) = ROW(%s)
+		  WHERE p.uuid = $1
+		  RETURNING `+playlistColumns, strings.Join(names, ","), strings.Join(ids, ","))
+
+	p := dto.PlaylistRead{}
+	err := scanPlaylist(c.db.QueryRow(ctx, q, values...), &p)
+	if err != nil {
+		return nil, mapPgError(err)
+	}
+	return &p, nil
+}
+
+// Delete
+// delete playlist with its entries, audios are kept
+func (c *PlaylistCRUD) Delete(ctx context.Context, uuid pgtype.UUID) error {
+	q := `DELETE FROM public.playlists WHERE uuid = $1`
+
+	tag, err := c.db.Exec(ctx, q, uuid)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// lockPlaylist
+// lock playlist row so concurrent entry changes are serialized,
+// playlist is marked as updated
+func lockPlaylist(ctx context.Context, trx pgx.Tx, uuid pgtype.UUID) error {
+	q := `UPDATE public.playlists SET updated_at = CURRENT_TIMESTAMP(3) WHERE uuid = $1 RETURNING uuid`
+
+	return trx.QueryRow(ctx, q, uuid).Scan(&uuid)
+}
+
+// AddEntry
+// insert entry at position shifting following entries down.
+// Zero or out of range position appends entry to the end
+func (c *PlaylistCRUD) AddEntry(ctx context.Context, playlistUUID pgtype.UUID, entry *dto.PlaylistEntryCreate) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	if err = lockPlaylist(ctx, trx, playlistUUID); err != nil {
+		return err
+	}
+
+	last, err := lastPlaylistPosition(ctx, trx, playlistUUID)
+	if err != nil {
+		return err
+	}
+
+	position := entry.Position
+	if position <= 0 || position > last {
+		position = last + 1
+	} else {
+		qShift := `UPDATE public.playlist_entries
+			  SET position = position + 1
+			  WHERE playlist_uuid = $1 AND position >= $2`
+		if _, err = trx.Exec(ctx, qShift, playlistUUID, position); err != nil {
+			return err
+		}
+	}
+
+	qEntry := `INSERT INTO public.playlist_entries
+		  (playlist_uuid, audio_uuid, position, created_at)
+		  VALUES ($1, $2, $3, CURRENT_TIMESTAMP(3))`
+	if _, err = trx.Exec(ctx, qEntry, playlistUUID, entry.AudioUUID, position); err != nil {
+		return mapPgError(err)
+	}
+
+	return mapPgError(trx.Commit(ctx))
+}
+
+// RemoveEntry
+// remove entry shifting following entries up
+func (c *PlaylistCRUD) RemoveEntry(ctx context.Context, playlistUUID, entryUUID pgtype.UUID) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	if err = lockPlaylist(ctx, trx, playlistUUID); err != nil {
+		return err
+	}
+
+	qEntry := `DELETE FROM public.playlist_entries
+		  WHERE playlist_uuid = $1 AND uuid = $2
+		  RETURNING position`
+	position := 0
+	if err = trx.QueryRow(ctx, qEntry, playlistUUID, entryUUID).Scan(&position); err != nil {
+		return err
+	}
+
+	qShift := `UPDATE public.playlist_entries
+		  SET position = position - 1
+		  WHERE playlist_uuid = $1 AND position > $2`
+	if _, err = trx.Exec(ctx, qShift, playlistUUID, position); err != nil {
+		return err
+	}
+
+	return mapPgError(trx.Commit(ctx))
+}
+
+// MoveEntry
+// move entry to position shifting entries between old and new
+// positions. Zero or out of range position moves entry to the end
+func (c *PlaylistCRUD) MoveEntry(ctx context.Context, playlistUUID, entryUUID pgtype.UUID, position int) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	if err = lockPlaylist(ctx, trx, playlistUUID); err != nil {
+		return err
+	}
+
+	qEntry := `SELECT position FROM public.playlist_entries WHERE playlist_uuid = $1 AND uuid = $2`
+	current := 0
+	if err = trx.QueryRow(ctx, qEntry, playlistUUID, entryUUID).Scan(&current); err != nil {
+		return err
+	}
+
+	last, err := lastPlaylistPosition(ctx, trx, playlistUUID)
+	if err != nil {
+		return err
+	}
+	if position <= 0 || position > last {
+		position = last
+	}
+
+	qShift := `UPDATE public.playlist_entries
+		  SET position = position + SIGN($2::int - $3::int)
+		  WHERE playlist_uuid = $1 AND uuid <> $4 AND position BETWEEN LEAST($2, $3) AND GREATEST($2, $3)`
+	if _, err = trx.Exec(ctx, qShift, playlistUUID, current, position, entryUUID); err != nil {
+		return err
+	}
+
+	qMove := `UPDATE public.playlist_entries SET position = $3 WHERE playlist_uuid = $1 AND uuid = $2`
+	if _, err = trx.Exec(ctx, qMove, playlistUUID, entryUUID, position); err != nil {
+		return err
+	}
+
+	return mapPgError(trx.Commit(ctx))
+}
+
+func lastPlaylistPosition(ctx context.Context, trx pgx.Tx, playlistUUID pgtype.UUID) (int, error) {
+	q := `SELECT COALESCE(MAX(position), 0) FROM public.playlist_entries WHERE playlist_uuid = $1`
+
+	last := 0
+	err := trx.QueryRow(ctx, q, playlistUUID).Scan(&last)
+	return last, err
+}
+
+// removeAudioEntries
+// remove entries of audio from all playlists and close gaps left
+// in positions, called before audio is deleted
+func removeAudioEntries(ctx context.Context, trx pgx.Tx, audioUUID pgtype.UUID) error {
+	qLock := `SELECT p.uuid FROM public.playlists p
+		  WHERE p.uuid IN (SELECT playlist_uuid FROM public.playlist_entries WHERE audio_uuid = $1)
+		  ORDER BY p.uuid
+		  FOR UPDATE`
+	rows, err := trx.Query(ctx, qLock, audioUUID)
+	if err != nil {
+		return err
+	}
+	playlists, err := pgx.CollectRows(rows, pgx.RowTo[pgtype.UUID])
+	if err != nil || len(playlists) == 0 {
+		return err
+	}
+
+	qEntries := `DELETE FROM public.playlist_entries WHERE audio_uuid = $1`
+	if _, err = trx.Exec(ctx, qEntries, audioUUID); err != nil {
+		return err
+	}
+
+	qTouch := `UPDATE public.playlists SET updated_at = CURRENT_TIMESTAMP(3) WHERE uuid = ANY($1)`
+	if _, err = trx.Exec(ctx, qTouch, playlists); err != nil {
+		return err
+	}
+
+	qCompact := `UPDATE public.playlist_entries e
+		  SET position = n.position
+		  FROM (
+			  SELECT uuid, ROW_NUMBER() OVER (PARTITION BY playlist_uuid ORDER BY position) AS position
+			  FROM public.playlist_entries
+			  WHERE playlist_uuid = ANY($1)
+		  ) n
+		  WHERE e.uuid = n.uuid AND e.position <> n.position`
+	_, err = trx.Exec(ctx, qCompact, playlists)
+	return err
+}
@@ -0,0 +1,100 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+)
+
+type ProvenanceCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewProvenanceCRUD(c Client, l logging.Logger) *ProvenanceCRUD {
+	return &ProvenanceCRUD{db: c, logger: l}
+}
+
+func (c *ProvenanceCRUD) ListByAudio(ctx context.Context, audioUUID pgtype.UUID) ([]dto.ProvenanceRead, error) {
+	q := `SELECT audio_uuid, field, source, editor, fetched_at, updated_at
+		  FROM public.audio_provenance
+		  WHERE audio_uuid = $1
+		  ORDER BY field`
+
+	rows, err := c.db.Query(ctx, q, audioUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	provenance := make([]dto.ProvenanceRead, 0, 5)
+	for rows.Next() {
+		p := dto.ProvenanceRead{}
+		err = rows.Scan(&p.AudioUUID, &p.Field, &p.Source, &p.Editor, &p.FetchedAt, &p.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		provenance = append(provenance, p)
+	}
+	return provenance, rows.Err()
+}
+
+// upsertProvenance
+// insert or replace provenance of audio fields in transaction
+func upsertProvenance(ctx context.Context, trx pgx.Tx, audioUUID pgtype.UUID, provenance []dto.ProvenanceCreate) error {
+	if len(provenance) == 0 {
+		return nil
+	}
+
+	q := `INSERT INTO public.audio_provenance
+		  (audio_uuid, field, source, editor, fetched_at, updated_at)
+		  VALUES `
+
+	counter := 1
+	qValues := make([]string, 0, len(provenance))
+	values := make([]any, 0, len(provenance)*5)
+	for i := 0; i < len(provenance); i++ {
+		qValue := fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, CURRENT_TIMESTAMP(3))",
+			counter, counter+1, counter+2, counter+3, counter+4)
+		counter += 5
+		qValues = append(qValues, qValue)
+
+		p := &provenance[i]
+		values = append(values, audioUUID, p.Field, p.Source, p.Editor, p.FetchedAt)
+	}
+
+	q += strings.Join(qValues, ",") + `
+		  ON CONFLICT (audio_uuid, field) DO UPDATE
+		  SET source = EXCLUDED.source,
+		      editor = EXCLUDED.editor,
+		      fetched_at = EXCLUDED.fetched_at,
+		      updated_at = EXCLUDED.updated_at;`
+
+	_, err := trx.Exec(ctx, q, values...)
+	return err
+}
+
+// upsertAudiosProvenance
+// insert or replace provenance of one field of many audios in transaction
+func upsertAudiosProvenance(ctx context.Context, trx pgx.Tx, audioUUIDs []pgtype.UUID, p dto.ProvenanceCreate) error {
+	if len(audioUUIDs) == 0 {
+		return nil
+	}
+
+	q := `INSERT INTO public.audio_provenance
+		  (audio_uuid, field, source, editor, fetched_at, updated_at)
+		  SELECT a.uuid, $2, $3, $4, $5, CURRENT_TIMESTAMP(3)
+		  FROM UNNEST($1::uuid[]) AS a(uuid)
+		  ON CONFLICT (audio_uuid, field) DO UPDATE
+		  SET source = EXCLUDED.source,
+		      editor = EXCLUDED.editor,
+		      fetched_at = EXCLUDED.fetched_at,
+		      updated_at = EXCLUDED.updated_at;`
+
+	_, err := trx.Exec(ctx, q, audioUUIDs, p.Field, p.Source, p.Editor, p.FetchedAt)
+	return err
+}
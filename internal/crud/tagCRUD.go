@@ -0,0 +1,128 @@
+package crud
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TagCRUD struct {
+	db     Client
+	logger logging.Logger
+}
+
+func NewTagCRUD(c Client, l logging.Logger) *TagCRUD {
+	return &TagCRUD{db: c, logger: l}
+}
+
+// selectAudioTags
+// return names of tags attached to audio ordered by name
+func selectAudioTags(ctx context.Context, db Client, audioUUID pgtype.UUID) ([]string, error) {
+	q := `SELECT t.name
+		  FROM public.audio_tags at
+		  JOIN public.tags t ON t.uuid = at.tag_uuid
+		  WHERE at.audio_uuid = $1
+		  ORDER BY t.name`
+
+	rows, err := db.Query(ctx, q, audioUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]string, 0)
+	for rows.Next() {
+		name := ""
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tags = append(tags, name)
+	}
+	return tags, rows.Err()
+}
+
+// tagCondition
+// return condition matching audios with any of tags given by names placeholder
+func tagCondition(placeholder string) string {
+	return `EXISTS (SELECT 1 FROM public.audio_tags at
+			  JOIN public.tags t ON t.uuid = at.tag_uuid
+			  WHERE at.audio_uuid = a.uuid AND t.name = ANY(` + placeholder + `))`
+}
+
+// ListByPag
+// return tags with number of tagged audios, most used first
+func (c *TagCRUD) ListByPag(ctx context.Context, pag Pagination) ([]dto.TagRead, error) {
+	q := `SELECT t.uuid, t.name, COUNT(at.audio_uuid), t.created_at
+		  FROM public.tags t
+		  LEFT JOIN public.audio_tags at ON at.tag_uuid = t.uuid
+		  GROUP BY t.uuid
+		  ORDER BY COUNT(at.audio_uuid) DESC, t.name
+		  LIMIT $1 OFFSET $2`
+
+	rows, err := c.db.Query(ctx, q, pag.Limit, pag.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]dto.TagRead, 0, pag.Limit)
+	for rows.Next() {
+		t := dto.TagRead{}
+		if err = rows.Scan(&t.UUID, &t.Name, &t.AudioCount, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+// Attach
+// attach tag to audio, tag is created if not exists.
+// Attaching twice is not an error
+func (c *TagCRUD) Attach(ctx context.Context, audioUUID pgtype.UUID, name string) error {
+	trx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer trx.Rollback(ctx)
+
+	qTag := `INSERT INTO public.tags
+		  (name, created_at)
+		  VALUES ($1, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+		  RETURNING uuid`
+
+	tagUUID := pgtype.UUID{}
+	if err = trx.QueryRow(ctx, qTag, name).Scan(&tagUUID); err != nil {
+		return err
+	}
+
+	qAudioTag := `INSERT INTO public.audio_tags
+		  (audio_uuid, tag_uuid, created_at)
+		  VALUES ($1, $2, CURRENT_TIMESTAMP(3))
+		  ON CONFLICT DO NOTHING`
+	if _, err = trx.Exec(ctx, qAudioTag, audioUUID, tagUUID); err != nil {
+		return mapPgError(err)
+	}
+
+	return trx.Commit(ctx)
+}
+
+// Detach
+// detach tag from audio, unused tag is kept
+func (c *TagCRUD) Detach(ctx context.Context, audioUUID pgtype.UUID, name string) error {
+	q := `DELETE FROM public.audio_tags at
+		  USING public.tags t
+		  WHERE t.uuid = at.tag_uuid AND at.audio_uuid = $1 AND t.name = $2`
+
+	tag, err := c.db.Exec(ctx, q, audioUUID, name)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
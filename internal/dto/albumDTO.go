@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"database/sql"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Album types
+const (
+	AlbumTypeAlbum       = "album"
+	AlbumTypeSingle      = "single"
+	AlbumTypeEP          = "ep"
+	AlbumTypeCompilation = "compilation"
+)
+
+type Album struct {
+	UUID        pgtype.UUID        `json:"uuid"`
+	ArtistUUID  pgtype.UUID        `json:"artist_uuid"`
+	Title       string             `json:"title"`
+	Type        string             `json:"type"`
+	ReleaseDate pgtype.Date        `json:"release_date"`
+	CoverKey    pgtype.Text        `json:"cover_key"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AlbumRead struct {
+	UUID        pgtype.UUID        `json:"uuid"`
+	ArtistUUID  pgtype.UUID        `json:"artist_uuid"`
+	Title       string             `json:"title"`
+	Type        string             `json:"type"`
+	ReleaseDate pgtype.Date        `json:"release_date"`
+	CoverKey    pgtype.Text        `json:"cover_key"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AlbumReadFull struct {
+	AlbumRead
+	Tracks []AlbumTrackRead `json:"tracks"`
+}
+
+type AlbumTrackRead struct {
+	AudioRead
+	Position int `json:"position"`
+}
+
+// AudioAlbum
+// album of audio with audio track position
+type AudioAlbum struct {
+	UUID        pgtype.UUID `json:"uuid"`
+	Title       string      `json:"title"`
+	Type        string      `json:"type"`
+	ReleaseDate pgtype.Date `json:"release_date"`
+	Position    int         `json:"position"`
+}
+
+type AlbumCreate struct {
+	ArtistUUID  pgtype.UUID `json:"artist_uuid"`
+	Title       string      `json:"title"`
+	Type        string      `json:"type"`
+	ReleaseDate pgtype.Date `json:"release_date"`
+}
+
+type AlbumUpdate struct {
+	ArtistUUID  pgtype.UUID    `json:"artist_uuid"`
+	Title       sql.NullString `json:"title"`
+	Type        sql.NullString `json:"type"`
+	ReleaseDate pgtype.Date    `json:"release_date"`
+}
+
+// AlbumTrackCreate
+// track to add, zero position appends track to the end
+type AlbumTrackCreate struct {
+	AudioUUID pgtype.UUID `json:"audio_uuid"`
+	Position  int         `json:"position"`
+}
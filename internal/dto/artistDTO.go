@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"database/sql"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Artist struct {
+	UUID        pgtype.UUID        `json:"uuid"`
+	Name        string             `json:"name"`
+	Country     string             `json:"country"`
+	Description string             `json:"description"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ArtistRead struct {
+	UUID        pgtype.UUID        `json:"uuid"`
+	Name        string             `json:"name"`
+	Country     string             `json:"country"`
+	Description string             `json:"description"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ArtistCreate struct {
+	Name        string `json:"name"`
+	Country     string `json:"country"`
+	Description string `json:"description"`
+}
+
+type ArtistUpdate struct {
+	Name        sql.NullString `json:"name"`
+	Country     sql.NullString `json:"country"`
+	Description sql.NullString `json:"description"`
+	Editor      string         `json:"editor"`
+}
+
+type ArtistAliasRead struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	ArtistUUID pgtype.UUID        `json:"artist_uuid"`
+	Alias      string             `json:"alias"`
+	Normalized string             `json:"normalized"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ArtistAliasCreate struct {
+	Alias string `json:"alias"`
+}
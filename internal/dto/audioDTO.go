@@ -5,70 +5,159 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// Release date precisions, date of coarser
+// precision is the first day of its year or month
+const (
+	PrecisionYear  = "year"
+	PrecisionMonth = "month"
+	PrecisionDay   = "day"
+)
+
+// Audio data sources
+const (
+	SourceInfo   = "info"
+	SourceManual = "manual"
+)
+
 type Audio struct {
-	UUID        pgtype.UUID        `json:"uuid"`
-	Group       string             `json:"group"`
-	Song        string             `json:"song"`
-	ReleaseDate pgtype.Date        `json:"release_date"`
-	Link        string             `json:"link"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	UUID                 pgtype.UUID        `json:"uuid"`
+	ArtistUUID           pgtype.UUID        `json:"artist_uuid"`
+	Group                string             `json:"group"`
+	Song                 string             `json:"song"`
+	ReleaseDate          pgtype.Date        `json:"release_date"`
+	ReleaseDatePrecision string             `json:"release_date_precision"`
+	Link                 string             `json:"link"`
+	DurationMs           pgtype.Int4        `json:"duration_ms"`
+	ISRC                 pgtype.Text        `json:"isrc"`
+	BPM                  pgtype.Float8      `json:"bpm"`
+	Key                  pgtype.Text        `json:"key"`
+	Explicit             bool               `json:"explicit"`
+	Source               string             `json:"source"`
+	CoverKey             pgtype.Text        `json:"cover_key"`
+	PlayCount            int64              `json:"play_count"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 }
 
 type AudioRead struct {
-	UUID        pgtype.UUID        `json:"uuid"`
-	Group       string             `json:"group"`
-	Song        string             `json:"song"`
-	ReleaseDate pgtype.Date        `json:"release_date"`
-	Link        string             `json:"link"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	UUID                 pgtype.UUID        `json:"uuid"`
+	ArtistUUID           pgtype.UUID        `json:"artist_uuid"`
+	Group                string             `json:"group"`
+	Song                 string             `json:"song"`
+	ReleaseDate          pgtype.Date        `json:"release_date"`
+	ReleaseDatePrecision string             `json:"release_date_precision"`
+	Link                 string             `json:"link"`
+	DurationMs           pgtype.Int4        `json:"duration_ms"`
+	ISRC                 pgtype.Text        `json:"isrc"`
+	BPM                  pgtype.Float8      `json:"bpm"`
+	Key                  pgtype.Text        `json:"key"`
+	Explicit             bool               `json:"explicit"`
+	Source               string             `json:"source"`
+	CoverKey             pgtype.Text        `json:"cover_key"`
+	PlayCount            int64              `json:"play_count"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 }
 
 type AudioReadFull struct {
-	UUID        pgtype.UUID        `json:"uuid"`
-	Group       string             `json:"group"`
-	Song        string             `json:"song"`
-	ReleaseDate pgtype.Date        `json:"release_date"`
-	Link        string             `json:"link"`
-	Lyrics      []LyricRead        `json:"lyrics"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	AudioRead
+	Lyrics  []LyricRead  `json:"lyrics"`
+	Album   *AudioAlbum  `json:"album"`
+	Credits []CreditRead `json:"credits"`
+	Genres  []GenreRead  `json:"genres"`
+	Tags    []string     `json:"tags"`
+	Links   []LinkRead   `json:"links"`
 }
 
 type AudioCreate struct {
-	Group string `json:"group"`
-	Song  string `json:"song"`
+	Group                string         `json:"group"`
+	Song                 string         `json:"song"`
+	ReleaseDate          pgtype.Date    `json:"release_date"`
+	ReleaseDatePrecision string         `json:"release_date_precision"`
+	Link                 sql.NullString `json:"link"`
+	LyricsRaw            sql.NullString `json:"lyrics_raw"`
+	DurationMs           pgtype.Int4    `json:"duration_ms"`
+	ISRC                 pgtype.Text    `json:"isrc"`
+	BPM                  pgtype.Float8  `json:"bpm"`
+	Key                  pgtype.Text    `json:"key"`
+	Explicit             pgtype.Bool    `json:"explicit"`
+	Source               string         `json:"source"`
+	Editor               string         `json:"editor"`
+}
+
+// IsComplete
+// return true if all info-service fields are supplied
+func (a *AudioCreate) IsComplete() bool {
+	return a.ReleaseDate.Valid && a.Link.Valid && a.LyricsRaw.Valid
 }
 
 type AudioCreateFull struct {
-	Group       string      `json:"group"`
-	Song        string      `json:"song"`
-	ReleaseDate pgtype.Date `json:"release_date"`
-	Link        string      `json:"link"`
-	Lyrics      []LyricCreate
+	Group                string        `json:"group"`
+	Song                 string        `json:"song"`
+	ReleaseDate          pgtype.Date   `json:"release_date"`
+	ReleaseDatePrecision string        `json:"release_date_precision"`
+	Link                 string        `json:"link"`
+	DurationMs           pgtype.Int4   `json:"duration_ms"`
+	ISRC                 pgtype.Text   `json:"isrc"`
+	BPM                  pgtype.Float8 `json:"bpm"`
+	Key                  pgtype.Text   `json:"key"`
+	Explicit             bool          `json:"explicit"`
+	Source               string        `json:"source"`
+	Lyrics               []LyricCreate
+	Provenance           []ProvenanceCreate
 }
 
 type AudioInfo struct {
-	ReleaseDate pgtype.Date `json:"releaseDate"`
-	Text        string      `json:"text"`
-	Link        string      `json:"link"`
+	ReleaseDate          pgtype.Date `json:"releaseDate"`
+	ReleaseDatePrecision string      `json:"releaseDatePrecision"`
+	Text                 string      `json:"text"`
+	Link                 string      `json:"link"`
+	Warnings             []string    `json:"warnings"`
 }
 
 type AudioUpdate struct {
-	Group       sql.NullString `json:"group"`
-	Song        sql.NullString `json:"song"`
-	ReleaseDate pgtype.Date    `json:"release_date"`
-	Link        sql.NullString `json:"link"`
-	LyricsRaw   sql.NullString `json:"lyric_raw"`
-	Lyrics      []LyricCreate
+	Group                sql.NullString `json:"group"`
+	Song                 sql.NullString `json:"song"`
+	ReleaseDate          pgtype.Date    `json:"release_date"`
+	ReleaseDatePrecision string         `json:"release_date_precision"`
+	Link                 sql.NullString `json:"link"`
+	LyricsRaw            sql.NullString `json:"lyric_raw"`
+	DurationMs           pgtype.Int4    `json:"duration_ms"`
+	ISRC                 pgtype.Text    `json:"isrc"`
+	BPM                  pgtype.Float8  `json:"bpm"`
+	Key                  pgtype.Text    `json:"key"`
+	Explicit             pgtype.Bool    `json:"explicit"`
+	Editor               string         `json:"editor"`
+	Lyrics               []LyricCreate
+	Provenance           []ProvenanceCreate
 }
 
 type AudioFilter struct {
 	Group             sql.NullString `json:"group"`
+	ArtistUUID        pgtype.UUID    `json:"artist_uuid"`
 	Song              sql.NullString `json:"song"`
 	ReleaseDateAfter  pgtype.Date    `json:"release_date_after"`
 	ReleaseDateBefore pgtype.Date    `json:"release_date_before"`
 	Link              sql.NullString `json:"link"`
 	Lyric             sql.NullString `json:"lyric"`
+	Credit            sql.NullString `json:"credit"`
+	CreditRole        sql.NullString `json:"credit_role"`
+	Genres            []string       `json:"genres"`
+	GenresMatchAll    bool           `json:"genres_match_all"`
+	Tags              []string       `json:"tags"`
+	TagsMatchAll      bool           `json:"tags_match_all"`
+	Platform          sql.NullString `json:"platform"`
+	DurationMin       pgtype.Int4    `json:"duration_min"`
+	DurationMax       pgtype.Int4    `json:"duration_max"`
+	ISRC              sql.NullString `json:"isrc"`
+	Explicit          pgtype.Bool    `json:"explicit"`
+	Sort              sql.NullString `json:"sort"`
+	SortDesc          bool           `json:"sort_desc"`
 }
+
+// Audio list sort keys
+const (
+	SortPlayCount   = "play_count"
+	SortCreatedAt   = "created_at"
+	SortReleaseDate = "release_date"
+)
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"eMobile/pkg/audiotag"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AudioFile struct {
+	AudioUUID  pgtype.UUID        `json:"audio_uuid"`
+	BlobKey    string             `json:"blob_key"`
+	Format     string             `json:"format"`
+	Size       int64              `json:"size"`
+	SHA256     string             `json:"sha256"`
+	DurationMs pgtype.Int4        `json:"duration_ms"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type AudioFileRead struct {
+	AudioUUID  pgtype.UUID        `json:"audio_uuid"`
+	BlobKey    string             `json:"blob_key"`
+	Format     string             `json:"format"`
+	Size       int64              `json:"size"`
+	SHA256     string             `json:"sha256"`
+	DurationMs pgtype.Int4        `json:"duration_ms"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type AudioFileCreate struct {
+	BlobKey    string      `json:"blob_key"`
+	Format     string      `json:"format"`
+	Size       int64       `json:"size"`
+	SHA256     string      `json:"sha256"`
+	DurationMs pgtype.Int4 `json:"duration_ms"`
+}
+
+// AudioFileUpload
+// stored audio file with tags read from it and
+// audio fields differing from tags
+type AudioFileUpload struct {
+	File      AudioFileRead   `json:"file"`
+	Tags      audiotag.Tags   `json:"tags"`
+	Suggested AudioSuggestion `json:"suggested"`
+}
+
+// AudioSuggestion
+// audio metadata suggested by file tags, only fields
+// missing or different in audio are valid
+type AudioSuggestion struct {
+	Group                pgtype.Text `json:"group"`
+	Song                 pgtype.Text `json:"song"`
+	ReleaseDate          pgtype.Date `json:"release_date"`
+	ReleaseDatePrecision string      `json:"release_date_precision"`
+	Lyrics               pgtype.Text `json:"lyrics"`
+	DurationMs           pgtype.Int4 `json:"duration_ms"`
+	Album                pgtype.Text `json:"album"`
+	TrackNumber          pgtype.Int4 `json:"track_number"`
+}
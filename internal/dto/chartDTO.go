@@ -0,0 +1,28 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// Chart periods
+const (
+	ChartPeriodWeek  = "week"
+	ChartPeriodMonth = "month"
+	ChartPeriodAll   = "all"
+)
+
+// Chart kinds, group charts artists
+const (
+	ChartByAudio = "audio"
+	ChartByGroup = "group"
+)
+
+// ChartEntryRead
+// position in chart, Audio or Artist is set by chart kind.
+// PreviousPosition is not valid for entries new in chart
+type ChartEntryRead struct {
+	Position         int64              `json:"position"`
+	PreviousPosition pgtype.Int8        `json:"previous_position"`
+	Plays            int64              `json:"plays"`
+	RefreshedAt      pgtype.Timestamptz `json:"refreshed_at"`
+	Audio            *AudioRead         `json:"audio"`
+	Artist           *ArtistRead        `json:"artist"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"path"
+	"strconv"
+)
+
+// Cover owners, value is name of table holding cover key
+const (
+	CoverTargetAudio = "audios"
+	CoverTargetAlbum = "albums"
+)
+
+// CoverKeyPrefix
+// blob keys of covers start with it, only these blobs are public
+const CoverKeyPrefix = "covers"
+
+// CoverSizes
+// longest side in pixels of generated cover thumbnails
+var CoverSizes = []int{64, 256, 640}
+
+// CoverThumbnailKey
+// return blob key of cover thumbnail of given size,
+// thumbnails are stored next to original image
+func CoverThumbnailKey(coverKey string, size int) string {
+	return path.Join(path.Dir(coverKey), strconv.Itoa(size)+".jpg")
+}
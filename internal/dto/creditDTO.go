@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// Credit roles
+const (
+	RolePerformer = "performer"
+	RoleFeatured  = "featured"
+	RoleWriter    = "writer"
+	RoleComposer  = "composer"
+	RoleLyricist  = "lyricist"
+	RoleProducer  = "producer"
+)
+
+type CreditRead struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	AudioUUID  pgtype.UUID        `json:"audio_uuid"`
+	PersonUUID pgtype.UUID        `json:"person_uuid"`
+	Name       string             `json:"name"`
+	Role       string             `json:"role"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+// CreditCreate
+// credit of person by name, person is created if not exists
+type CreditCreate struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// FavoriteRead
+// audio liked by user
+type FavoriteRead struct {
+	AudioRead
+	FavoritedAt pgtype.Timestamptz `json:"favorited_at"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+type GenreRead struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	ParentUUID pgtype.UUID        `json:"parent_uuid"`
+	Name       string             `json:"name"`
+	Slug       string             `json:"slug"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type GenreCreate struct {
+	ParentUUID pgtype.UUID `json:"parent_uuid"`
+	Name       string      `json:"name"`
+	Slug       string      `json:"slug"`
+}
+
+type TagRead struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	Name       string             `json:"name"`
+	AudioCount int                `json:"audio_count"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
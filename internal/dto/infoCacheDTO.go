@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// InfoCacheEntry
+// cached info service response. NotFound entries cache 404 responses
+type InfoCacheEntry struct {
+	Key                  string             `json:"key"`
+	ReleaseDate          pgtype.Date        `json:"release_date"`
+	ReleaseDatePrecision string             `json:"release_date_precision"`
+	Text                 string             `json:"text"`
+	Link                 string             `json:"link"`
+	NotFound             bool               `json:"not_found"`
+	ExpiresAt            pgtype.Timestamptz `json:"expires_at"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+type Link struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	AudioUUID  pgtype.UUID        `json:"audio_uuid"`
+	Platform   string             `json:"platform"`
+	URL        string             `json:"url"`
+	ExternalID string             `json:"external_id"`
+	IsPrimary  bool               `json:"is_primary"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type LinkRead struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	AudioUUID  pgtype.UUID        `json:"audio_uuid"`
+	Platform   string             `json:"platform"`
+	URL        string             `json:"url"`
+	ExternalID string             `json:"external_id"`
+	IsPrimary  bool               `json:"is_primary"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type LinkCreate struct {
+	Platform   string `json:"platform"`
+	URL        string `json:"url"`
+	ExternalID string `json:"external_id"`
+	IsPrimary  bool   `json:"is_primary"`
+	Editor     string `json:"editor"`
+}
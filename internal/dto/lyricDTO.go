@@ -16,6 +16,7 @@ type LyricRead struct {
 	AudioUUID pgtype.UUID        `json:"audio_uuid"`
 	Order     int                `json:"order"`
 	Text      string             `json:"text"`
+	Explicit  bool               `json:"explicit"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
@@ -24,4 +25,5 @@ type LyricCreate struct {
 	AudioUUID pgtype.UUID `json:"audio_uuid"`
 	Order     int         `json:"order"`
 	Text      string      `json:"text"`
+	Explicit  pgtype.Bool `json:"explicit"`
 }
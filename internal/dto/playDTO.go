@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+type PlayRead struct {
+	UUID       pgtype.UUID        `json:"uuid"`
+	UserID     string             `json:"user_id"`
+	AudioUUID  pgtype.UUID        `json:"audio_uuid"`
+	PlayedAt   pgtype.Timestamptz `json:"played_at"`
+	DurationMs pgtype.Int4        `json:"duration_ms"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+// PlayCreate
+// listen of audio, duration is time played
+type PlayCreate struct {
+	UserID     string             `json:"user_id"`
+	PlayedAt   pgtype.Timestamptz `json:"played_at"`
+	DurationMs pgtype.Int4        `json:"duration_ms"`
+}
+
+// PlayHistoryRead
+// audio played by user
+type PlayHistoryRead struct {
+	AudioRead
+	PlayUUID       pgtype.UUID        `json:"play_uuid"`
+	PlayedAt       pgtype.Timestamptz `json:"played_at"`
+	PlayDurationMs pgtype.Int4        `json:"play_duration_ms"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"database/sql"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Playlist struct {
+	UUID        pgtype.UUID        `json:"uuid"`
+	Owner       string             `json:"owner"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type PlaylistRead struct {
+	UUID        pgtype.UUID        `json:"uuid"`
+	Owner       string             `json:"owner"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	EntryCount  int                `json:"entry_count"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type PlaylistReadFull struct {
+	PlaylistRead
+	Entries []PlaylistEntryRead `json:"entries"`
+}
+
+// PlaylistEntryRead
+// audio in playlist, the same audio may be in several entries
+type PlaylistEntryRead struct {
+	AudioRead
+	EntryUUID pgtype.UUID        `json:"entry_uuid"`
+	Position  int                `json:"position"`
+	AddedAt   pgtype.Timestamptz `json:"added_at"`
+}
+
+type PlaylistCreate struct {
+	Owner       string `json:"owner"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type PlaylistUpdate struct {
+	Name        sql.NullString `json:"name"`
+	Description sql.NullString `json:"description"`
+}
+
+// PlaylistEntryCreate
+// entry to add, zero position appends entry to the end
+type PlaylistEntryCreate struct {
+	AudioUUID pgtype.UUID `json:"audio_uuid"`
+	Position  int         `json:"position"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// Audio fields with tracked provenance
+const (
+	FieldGroup       = "group"
+	FieldSong        = "song"
+	FieldReleaseDate = "release_date"
+	FieldLink        = "link"
+	FieldLyrics      = "lyrics"
+	FieldExplicit    = "explicit"
+)
+
+// SourceClassifier
+// source of explicit flag detected from lyrics
+const SourceClassifier = "classifier"
+
+type ProvenanceRead struct {
+	AudioUUID pgtype.UUID        `json:"audio_uuid"`
+	Field     string             `json:"field"`
+	Source    string             `json:"source"`
+	Editor    string             `json:"editor"`
+	FetchedAt pgtype.Timestamptz `json:"fetched_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ProvenanceCreate struct {
+	Field     string             `json:"field"`
+	Source    string             `json:"source"`
+	Editor    string             `json:"editor"`
+	FetchedAt pgtype.Timestamptz `json:"fetched_at"`
+}
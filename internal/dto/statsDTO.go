@@ -0,0 +1,24 @@
+package dto
+
+// AudioStats
+// aggregates of audio catalog. AvgVerses is among audios with lyrics,
+// audios without release date are not counted by year
+type AudioStats struct {
+	Audios             int64            `json:"audios"`
+	WithoutLyrics      int64            `json:"without_lyrics"`
+	WithoutLink        int64            `json:"without_link"`
+	WithoutReleaseDate int64            `json:"without_release_date"`
+	AvgVerses          float64          `json:"avg_verses"`
+	ByGroup            []GroupAudioStat `json:"by_group"`
+	ByYear             []YearAudioStat  `json:"by_year"`
+}
+
+type GroupAudioStat struct {
+	Group  string `json:"group"`
+	Audios int64  `json:"audios"`
+}
+
+type YearAudioStat struct {
+	Year   int32 `json:"year"`
+	Audios int64 `json:"audios"`
+}
@@ -6,19 +6,48 @@ import (
 	"eMobile/internal/dto"
 	"eMobile/pkg/logging"
 	"github.com/jackc/pgx/v5/pgtype"
+	"time"
 )
 
 type Repository struct {
-	Audio AudioRepository
-	Lyric LyricRepository
+	Audio      AudioRepository
+	Lyric      LyricRepository
+	InfoCache  InfoCacheRepository
+	Provenance ProvenanceRepository
+	Artist     ArtistRepository
+	Album      AlbumRepository
+	Credit     CreditRepository
+	Genre      GenreRepository
+	Tag        TagRepository
+	Link       LinkRepository
+	Cover      CoverRepository
+	AudioFile  AudioFileRepository
+	Playlist   PlaylistRepository
+	Favorite   FavoriteRepository
+	Play       PlayRepository
+	Chart      ChartRepository
 }
 
 // NewRepository
 // return all-in-one repository
 func NewRepository(c crud.Client, l logging.Logger) Repository {
 	return Repository{
-		Audio: crud.NewAudioCRUD(c, l),
-		Lyric: crud.NewLyricCRUD(c, l),
+		Audio:      crud.NewAudioCRUD(c, l),
+		Lyric:      crud.NewLyricCRUD(c, l),
+		InfoCache:  crud.NewInfoCacheCRUD(c, l),
+		Provenance: crud.NewProvenanceCRUD(c, l),
+		Artist:     crud.NewArtistCRUD(c, l),
+		Album:      crud.NewAlbumCRUD(c, l),
+		Credit:     crud.NewCreditCRUD(c, l),
+		Genre:      crud.NewGenreCRUD(c, l),
+		Tag:        crud.NewTagCRUD(c, l),
+		Link:       crud.NewLinkCRUD(c, l),
+		Cover:      crud.NewCoverCRUD(c, l),
+		AudioFile:  crud.NewAudioFileCRUD(c, l),
+		Playlist:   crud.NewPlaylistCRUD(c, l),
+		Favorite:   crud.NewFavoriteCRUD(c, l),
+		Play:       crud.NewPlayCRUD(c, l),
+		Chart:      crud.NewChartCRUD(c, l),
 	}
 }
 
@@ -28,12 +57,120 @@ type AudioRepository interface {
 	FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.AudioRead, error)
 	FindByUUIDWithLyrics(ctx context.Context, uuid pgtype.UUID) (*dto.AudioReadFull, error)
 	ListByFilter(ctx context.Context, filter *dto.AudioFilter, pag crud.Pagination) ([]dto.AudioRead, error)
+	Stats(ctx context.Context, filter *dto.AudioFilter) (*dto.AudioStats, error)
 	Update(ctx context.Context, uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.AudioRead, error)
-	Delete(ctx context.Context, uuid pgtype.UUID) error
+	Delete(ctx context.Context, uuid pgtype.UUID) ([]string, error)
 }
 
 type LyricRepository interface {
 	ListByAudioPag(ctx context.Context, audioUUID pgtype.UUID, pag crud.Pagination) ([]dto.LyricRead, error)
 	FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.LyricRead, error)
 	DeleteAllByAudio(ctx context.Context, uuid pgtype.UUID) error
+	ExplicitFingerprint(ctx context.Context) (string, error)
+	ResetExplicit(ctx context.Context, fingerprint string) error
+	FillExplicit(ctx context.Context, isExplicit func(text string) bool, limit int) (int, error)
+}
+
+type InfoCacheRepository interface {
+	Find(ctx context.Context, key string) (*dto.InfoCacheEntry, error)
+	Upsert(ctx context.Context, entry *dto.InfoCacheEntry) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type ProvenanceRepository interface {
+	ListByAudio(ctx context.Context, audioUUID pgtype.UUID) ([]dto.ProvenanceRead, error)
+}
+
+type ArtistRepository interface {
+	Create(ctx context.Context, artist *dto.ArtistCreate) (pgtype.UUID, error)
+	FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.ArtistRead, error)
+	ListByPag(ctx context.Context, pag crud.Pagination) ([]dto.ArtistRead, error)
+	ListAudios(ctx context.Context, uuid pgtype.UUID, pag crud.Pagination) ([]dto.AudioRead, error)
+	Update(ctx context.Context, uuid pgtype.UUID, artist *dto.ArtistUpdate) (*dto.ArtistRead, error)
+	Delete(ctx context.Context, uuid pgtype.UUID) error
+	FindByAlias(ctx context.Context, name string) (*dto.ArtistRead, error)
+	FillNormalizedNames(ctx context.Context) (int, error)
+	ListAliases(ctx context.Context, artistUUID pgtype.UUID) ([]dto.ArtistAliasRead, error)
+	CreateAlias(ctx context.Context, artistUUID pgtype.UUID, alias *dto.ArtistAliasCreate) (pgtype.UUID, error)
+	DeleteAlias(ctx context.Context, artistUUID, aliasUUID pgtype.UUID) error
+	Merge(ctx context.Context, targetUUID, sourceUUID pgtype.UUID, editor string) (*dto.ArtistRead, error)
+}
+
+type AlbumRepository interface {
+	Create(ctx context.Context, album *dto.AlbumCreate) (pgtype.UUID, error)
+	FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.AlbumRead, error)
+	FindByUUIDWithTracks(ctx context.Context, uuid pgtype.UUID) (*dto.AlbumReadFull, error)
+	ListByPag(ctx context.Context, pag crud.Pagination) ([]dto.AlbumRead, error)
+	Update(ctx context.Context, uuid pgtype.UUID, album *dto.AlbumUpdate) (*dto.AlbumRead, error)
+	Delete(ctx context.Context, uuid pgtype.UUID) error
+	AddTrack(ctx context.Context, albumUUID pgtype.UUID, track *dto.AlbumTrackCreate) error
+	RemoveTrack(ctx context.Context, albumUUID, audioUUID pgtype.UUID) error
+	ReplaceTracks(ctx context.Context, albumUUID pgtype.UUID, audioUUIDs []pgtype.UUID) error
+}
+
+type CreditRepository interface {
+	ListByAudio(ctx context.Context, audioUUID pgtype.UUID) ([]dto.CreditRead, error)
+	Create(ctx context.Context, audioUUID pgtype.UUID, credit *dto.CreditCreate) (pgtype.UUID, error)
+	Delete(ctx context.Context, audioUUID, creditUUID pgtype.UUID) error
+}
+
+type GenreRepository interface {
+	Create(ctx context.Context, genre *dto.GenreCreate) (pgtype.UUID, error)
+	FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.GenreRead, error)
+	ListByPag(ctx context.Context, pag crud.Pagination) ([]dto.GenreRead, error)
+	Delete(ctx context.Context, uuid pgtype.UUID) error
+	Attach(ctx context.Context, audioUUID, genreUUID pgtype.UUID) error
+	Detach(ctx context.Context, audioUUID, genreUUID pgtype.UUID) error
+}
+
+type TagRepository interface {
+	ListByPag(ctx context.Context, pag crud.Pagination) ([]dto.TagRead, error)
+	Attach(ctx context.Context, audioUUID pgtype.UUID, name string) error
+	Detach(ctx context.Context, audioUUID pgtype.UUID, name string) error
+}
+
+type LinkRepository interface {
+	ListByAudio(ctx context.Context, audioUUID pgtype.UUID) ([]dto.LinkRead, error)
+	Create(ctx context.Context, audioUUID pgtype.UUID, link *dto.LinkCreate) (pgtype.UUID, error)
+	SetPrimary(ctx context.Context, audioUUID, linkUUID pgtype.UUID, editor string) error
+	Delete(ctx context.Context, audioUUID, linkUUID pgtype.UUID, editor string) error
+	CanonicalizeURLs(ctx context.Context) (int, error)
+}
+
+type CoverRepository interface {
+	SetKey(ctx context.Context, target string, uuid pgtype.UUID, key pgtype.Text) (pgtype.Text, error)
+}
+
+type AudioFileRepository interface {
+	Upsert(ctx context.Context, audioUUID pgtype.UUID, file *dto.AudioFileCreate) (*dto.AudioFileRead, pgtype.Text, error)
+	FindByAudio(ctx context.Context, audioUUID pgtype.UUID) (*dto.AudioFileRead, error)
+}
+
+type PlaylistRepository interface {
+	Create(ctx context.Context, playlist *dto.PlaylistCreate) (pgtype.UUID, error)
+	FindByUUID(ctx context.Context, uuid pgtype.UUID) (*dto.PlaylistRead, error)
+	FindByUUIDWithEntries(ctx context.Context, uuid pgtype.UUID) (*dto.PlaylistReadFull, error)
+	ListByOwnerPag(ctx context.Context, owner string, pag crud.Pagination) ([]dto.PlaylistRead, error)
+	Update(ctx context.Context, uuid pgtype.UUID, playlist *dto.PlaylistUpdate) (*dto.PlaylistRead, error)
+	Delete(ctx context.Context, uuid pgtype.UUID) error
+	AddEntry(ctx context.Context, playlistUUID pgtype.UUID, entry *dto.PlaylistEntryCreate) error
+	RemoveEntry(ctx context.Context, playlistUUID, entryUUID pgtype.UUID) error
+	MoveEntry(ctx context.Context, playlistUUID, entryUUID pgtype.UUID, position int) error
+}
+
+type FavoriteRepository interface {
+	Add(ctx context.Context, userID string, audioUUID pgtype.UUID) error
+	Remove(ctx context.Context, userID string, audioUUID pgtype.UUID) error
+	ListByUserPag(ctx context.Context, userID string, pag crud.Pagination) ([]dto.FavoriteRead, error)
+	FilterAudios(ctx context.Context, userID string, audioUUIDs []pgtype.UUID) ([]pgtype.UUID, error)
+}
+
+type PlayRepository interface {
+	Create(ctx context.Context, audioUUID pgtype.UUID, play *dto.PlayCreate, window time.Duration) (*dto.PlayRead, bool, error)
+	ListHistoryByUserPag(ctx context.Context, userID string, pag crud.Pagination) ([]dto.PlayHistoryRead, error)
+}
+
+type ChartRepository interface {
+	ListByPeriodPag(ctx context.Context, period, kind string, pag crud.Pagination) ([]dto.ChartEntryRead, error)
+	Refresh(ctx context.Context) error
 }
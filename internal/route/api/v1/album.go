@@ -0,0 +1,373 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initAlbumHandler(r *httprouter.Router) {
+	r.POST("/api/v1/albums", h.albumCreate)
+	r.GET("/api/v1/albums", h.albumList)
+	r.GET("/api/v1/albums/:uuid", h.albumFindByUUID)
+	r.PATCH("/api/v1/albums/:uuid", h.albumUpdateByUUID)
+	r.DELETE("/api/v1/albums/:uuid", h.albumDeleteByUUID)
+
+	r.POST("/api/v1/albums/:uuid/tracks", h.albumTrackAdd)
+	r.PUT("/api/v1/albums/:uuid/tracks", h.albumTracksReplace)
+	r.DELETE("/api/v1/albums/:uuid/tracks/:audio_uuid", h.albumTrackRemove)
+}
+
+// albumCreate godoc
+// @Tags         Album API
+// @Summary      Create album
+// @Description  Create album. Artist is optional for compilations
+// @Accept       json
+// @Produce      json
+// @Param Album body schema.RequestAlbumCreate false "Album base"
+// @Success      201  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums [post]
+func (h *Handler) albumCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	album := schema.RequestAlbumCreate{}
+
+	err := json.NewDecoder(r.Body).Decode(&album)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	albumDTO, err := album.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	uuid, err := h.s.Album.Create(albumDTO)
+	if err != nil {
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "artist does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "create album err")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: uuid}, "album created correctly")
+}
+
+// albumList godoc
+// @Tags         Album API
+// @Summary      List albums
+// @Description  List albums ordered by release date
+// @Accept       json
+// @Produce      json
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseAlbumRead]
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums [get]
+func (h *Handler) albumList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	albums, err := h.s.Album.ListPag(pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list albums")
+		return
+	}
+
+	albumSchemas := make([]schema.ResponseAlbumRead, 0, len(albums))
+	for i := 0; i < len(albums); i++ {
+		a := schema.ResponseAlbumRead{}
+		a.FromDTO(&albums[i])
+		albumSchemas = append(albumSchemas, a)
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, albumSchemas, "albums got correctly")
+}
+
+// albumFindByUUID godoc
+// @Tags         Album API
+// @Summary      Find album by UUID
+// @Description  Find album by UUID with tracks ordered by position
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string false "User ID, audios are marked with is_favorite"
+// @Param uuid path string false "Album UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseAlbumReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid} [get]
+func (h *Handler) albumFindByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	album, err := h.s.Album.Find(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows find")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find album by uuid")
+		return
+	}
+
+	albumSchema := schema.ResponseAlbumReadFull{}
+	albumSchema.FromDTOFull(album)
+
+	tracks := albumSchema.Tracks
+	err = h.markFavorites(r, len(tracks), func(i int) *schema.ResponseAudioRead { return &tracks[i].ResponseAudioRead })
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find favorites")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, albumSchema, "album got correctly")
+}
+
+// albumUpdateByUUID godoc
+// @Tags         Album API
+// @Summary      Update album by UUID
+// @Description  Update album by UUID
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Param Album body schema.RequestAlbumUpdate false "Album update base"
+// @Success      200  {object}  ResponseBase[schema.ResponseAlbumRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid} [patch]
+func (h *Handler) albumUpdateByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	album := schema.RequestAlbumUpdate{}
+	err = json.NewDecoder(r.Body).Decode(&album)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	albumDTO, err := album.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	readAlbumDTO, err := h.s.Album.Update(uuid, albumDTO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "artist does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on update album")
+		return
+	}
+
+	readAlbumSchema := schema.ResponseAlbumRead{}
+	readAlbumSchema.FromDTO(readAlbumDTO)
+
+	WriteResponse(w, http.StatusOK, readAlbumSchema, "album updated correctly")
+}
+
+// albumDeleteByUUID godoc
+// @Tags         Album API
+// @Summary      Delete album by UUID
+// @Description  Delete album by UUID. Album audios are kept
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid} [delete]
+func (h *Handler) albumDeleteByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Album.Delete(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete album by uuid")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "album deleted correctly")
+}
+
+// albumTrackAdd godoc
+// @Tags         Album API
+// @Summary      Add track to album
+// @Description  Add audio to album at position, following tracks are shifted. Omitted position appends track to the end
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Param Track body schema.RequestAlbumTrackAdd false "Track"
+// @Success      200  {object}  ResponseBase[schema.ResponseAlbumReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid}/tracks [post]
+func (h *Handler) albumTrackAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	track := schema.RequestAlbumTrackAdd{}
+	err = json.NewDecoder(r.Body).Decode(&track)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	trackDTO, err := track.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	album, err := h.s.Album.AddTrack(uuid, trackDTO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "track already in album")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on add album track")
+		return
+	}
+
+	albumSchema := schema.ResponseAlbumReadFull{}
+	albumSchema.FromDTOFull(album)
+
+	WriteResponse(w, http.StatusOK, albumSchema, "track added correctly")
+}
+
+// albumTracksReplace godoc
+// @Tags         Album API
+// @Summary      Replace album tracks
+// @Description  Set album tracks to audios in given order. Used to reorder tracks
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Param Tracks body schema.RequestAlbumTracksReplace false "Ordered audio UUIDs"
+// @Success      200  {object}  ResponseBase[schema.ResponseAlbumReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid}/tracks [put]
+func (h *Handler) albumTracksReplace(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	tracks := schema.RequestAlbumTracksReplace{}
+	err = json.NewDecoder(r.Body).Decode(&tracks)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	audioUUIDs, err := tracks.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	album, err := h.s.Album.ReplaceTracks(uuid, audioUUIDs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on replace album tracks")
+		return
+	}
+
+	albumSchema := schema.ResponseAlbumReadFull{}
+	albumSchema.FromDTOFull(album)
+
+	WriteResponse(w, http.StatusOK, albumSchema, "tracks replaced correctly")
+}
+
+// albumTrackRemove godoc
+// @Tags         Album API
+// @Summary      Remove track from album
+// @Description  Remove audio from album, following tracks are shifted
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Param audio_uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseAlbumReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid}/tracks/{audio_uuid} [delete]
+func (h *Handler) albumTrackRemove(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	audioUUID, err := h.getNamedUUIDParam(ps, "audio_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	album, err := h.s.Album.RemoveTrack(uuid, audioUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on remove album track")
+		return
+	}
+
+	albumSchema := schema.ResponseAlbumReadFull{}
+	albumSchema.FromDTOFull(album)
+
+	WriteResponse(w, http.StatusOK, albumSchema, "track removed correctly")
+}
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_albumCreate(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAlbumService, album *dto.AlbumCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.AlbumCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_valid_input",
+			inputBody: `{"title": "Abbey Road", "artist_uuid": "00000000-0000-0000-0000-000000000000", "release_date": "1969-09-26"}`,
+			inputDTO: &dto.AlbumCreate{
+				ArtistUUID:  pgtype.UUID{Valid: true},
+				Title:       "Abbey Road",
+				Type:        dto.AlbumTypeAlbum,
+				ReleaseDate: pgtype.Date{Time: time.Date(1969, 9, 26, 0, 0, 0, 0, time.UTC), Valid: true},
+			},
+			mockBehaviour: func(s *mockservice.MockIAlbumService, album *dto.AlbumCreate) {
+				s.EXPECT().Create(album).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"album created correctly"}`,
+		},
+		{
+			name:      "201_compilation_without_artist",
+			inputBody: `{"title": "Now 1", "type": "compilation"}`,
+			inputDTO:  &dto.AlbumCreate{Title: "Now 1", Type: dto.AlbumTypeCompilation},
+			mockBehaviour: func(s *mockservice.MockIAlbumService, album *dto.AlbumCreate) {
+				s.EXPECT().Create(album).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"album created correctly"}`,
+		},
+		{
+			name:          "400_invalid_input",
+			inputBody:     `{"title": " ", "type": "lp"}`,
+			mockBehaviour: func(s *mockservice.MockIAlbumService, album *dto.AlbumCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'title' is required and cannot be empty;'type' must be one of: album, single, ep, compilation;'artist_uuid' is required for non compilation album;", "message":"validation err"}`,
+		},
+		{
+			name:      "409_unknown_artist",
+			inputBody: `{"title": "Abbey Road", "artist_uuid": "00000000-0000-0000-0000-000000000000"}`,
+			inputDTO:  &dto.AlbumCreate{ArtistUUID: pgtype.UUID{Valid: true}, Title: "Abbey Road", Type: dto.AlbumTypeAlbum},
+			mockBehaviour: func(s *mockservice.MockIAlbumService, album *dto.AlbumCreate) {
+				s.EXPECT().Create(album).Return(pgtype.UUID{}, fmt.Errorf("%w: artist_uuid", crud.ErrForeignKeyViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"referenced by other rows: artist_uuid", "message":"artist does not exist"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			albumService := mockservice.NewMockIAlbumService(c)
+			testCase.mockBehaviour(albumService, testCase.inputDTO)
+
+			services := service.Service{Album: albumService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/albums", handler.albumCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/albums", strings.NewReader(testCase.inputBody))
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_albumTrackAdd(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAlbumService, uuid pgtype.UUID, track *dto.AlbumTrackCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.AlbumTrackCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "200_track_added",
+			inputBody: `{"audio_uuid": "00000000-0000-0000-0000-000000000000", "position": 1}`,
+			inputDTO:  &dto.AlbumTrackCreate{AudioUUID: pgtype.UUID{Valid: true}, Position: 1},
+			mockBehaviour: func(s *mockservice.MockIAlbumService, uuid pgtype.UUID, track *dto.AlbumTrackCreate) {
+				s.EXPECT().AddTrack(uuid, track).Return(&dto.AlbumReadFull{
+					AlbumRead: dto.AlbumRead{
+						UUID:       uuid,
+						ArtistUUID: pgtype.UUID{Valid: true},
+						Title:      "album1",
+						Type:       dto.AlbumTypeAlbum,
+					},
+					Tracks: []dto.AlbumTrackRead{
+						{
+							AudioRead: dto.AudioRead{
+								UUID:       pgtype.UUID{Valid: true},
+								ArtistUUID: pgtype.UUID{Valid: true},
+								Group:      "group1",
+								Song:       "song1",
+							},
+							Position: 1,
+						},
+					},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data": {"uuid":"00000000-0000-0000-0000-000000000000", "artist_uuid":"00000000-0000-0000-0000-000000000000", "title":"album1", "type":"album", "release_date":null, "created_at":null, "updated_at":null, "tracks": [{"uuid":"00000000-0000-0000-0000-000000000000", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "song":"song1", "release_date":null, "link":"", "created_at":null, "updated_at":null, "position":1}]}, "message":"track added correctly"}`,
+		},
+		{
+			name:          "400_negative_position",
+			inputBody:     `{"audio_uuid": "00000000-0000-0000-0000-000000000000", "position": -1}`,
+			mockBehaviour: func(s *mockservice.MockIAlbumService, uuid pgtype.UUID, track *dto.AlbumTrackCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'position' cannot be negative;", "message":"validation error"}`,
+		},
+		{
+			name:      "409_already_in_album",
+			inputBody: `{"audio_uuid": "00000000-0000-0000-0000-000000000000"}`,
+			inputDTO:  &dto.AlbumTrackCreate{AudioUUID: pgtype.UUID{Valid: true}},
+			mockBehaviour: func(s *mockservice.MockIAlbumService, uuid pgtype.UUID, track *dto.AlbumTrackCreate) {
+				s.EXPECT().AddTrack(uuid, track).Return(nil, fmt.Errorf("%w: audio_uuid", crud.ErrUniqueViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"already exists: audio_uuid", "message":"track already in album"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			albumService := mockservice.NewMockIAlbumService(c)
+			testCase.mockBehaviour(albumService, pgtype.UUID{Valid: true}, testCase.inputDTO)
+
+			services := service.Service{Album: albumService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/albums/:uuid/tracks", handler.albumTrackAdd)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/albums/00000000-0000-0000-0000-000000000000/tracks", strings.NewReader(testCase.inputBody))
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,449 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"eMobile/internal/service/artistService"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initArtistHandler(r *httprouter.Router) {
+	r.POST("/api/v1/artists", h.artistCreate)
+	r.GET("/api/v1/artists", h.artistList)
+	r.GET("/api/v1/artists/:uuid", h.artistFindByUUID)
+	r.PATCH("/api/v1/artists/:uuid", h.artistUpdateByUUID)
+	r.DELETE("/api/v1/artists/:uuid", h.artistDeleteByUUID)
+
+	r.GET("/api/v1/artists/:uuid/audios", h.artistAudiosList)
+	r.POST("/api/v1/artists/:uuid/merge", h.artistMerge)
+
+	r.GET("/api/v1/artists/:uuid/aliases", h.artistAliasList)
+	r.POST("/api/v1/artists/:uuid/aliases", h.artistAliasCreate)
+	r.DELETE("/api/v1/artists/:uuid/aliases/:alias_uuid", h.artistAliasDelete)
+}
+
+// artistCreate godoc
+// @Tags         Artist API
+// @Summary      Create artist
+// @Description  Create artist
+// @Accept       json
+// @Produce      json
+// @Param Artist body schema.RequestArtistCreate false "Artist base"
+// @Success      201  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists [post]
+func (h *Handler) artistCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	artist := schema.RequestArtistCreate{}
+
+	err := json.NewDecoder(r.Body).Decode(&artist)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	artistDTO, err := artist.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	uuid, err := h.s.Artist.Create(artistDTO)
+	if err != nil {
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "artist already exists")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "create artist err")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: uuid}, "artist created correctly")
+}
+
+// artistList godoc
+// @Tags         Artist API
+// @Summary      List artists
+// @Description  List artists ordered by name
+// @Accept       json
+// @Produce      json
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseArtistRead]
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists [get]
+func (h *Handler) artistList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	artists, err := h.s.Artist.ListPag(pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list artists")
+		return
+	}
+
+	artistSchemas := make([]schema.ResponseArtistRead, 0, len(artists))
+	for i := 0; i < len(artists); i++ {
+		a := schema.ResponseArtistRead{}
+		a.FromDTO(&artists[i])
+		artistSchemas = append(artistSchemas, a)
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, artistSchemas, "artists got correctly")
+}
+
+// artistFindByUUID godoc
+// @Tags         Artist API
+// @Summary      Find artist by UUID
+// @Description  Find artist by UUID
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Artist UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseArtistRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid} [get]
+func (h *Handler) artistFindByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	artist, err := h.s.Artist.Find(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows find")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find artist by uuid")
+		return
+	}
+
+	artistSchema := schema.ResponseArtistRead{}
+	artistSchema.FromDTO(artist)
+
+	WriteResponse(w, http.StatusOK, artistSchema, "artist got correctly")
+}
+
+// artistUpdateByUUID godoc
+// @Tags         Artist API
+// @Summary      Update artist by UUID
+// @Description  Update artist by UUID. Rename updates "group" of all artist audios
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string false "Editor id"
+// @Param uuid path string false "Artist UUID"
+// @Param Artist body schema.RequestArtistUpdate false "Artist update base"
+// @Success      200  {object}  ResponseBase[schema.ResponseArtistRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid} [patch]
+func (h *Handler) artistUpdateByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	artist := schema.RequestArtistUpdate{}
+	err = json.NewDecoder(r.Body).Decode(&artist)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	artistDTO, err := artist.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+	artistDTO.Editor = h.getUserID(r)
+
+	readArtistDTO, err := h.s.Artist.Update(uuid, artistDTO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "artist already exists")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on update artist")
+		return
+	}
+
+	readArtistSchema := schema.ResponseArtistRead{}
+	readArtistSchema.FromDTO(readArtistDTO)
+
+	WriteResponse(w, http.StatusOK, readArtistSchema, "artist updated correctly")
+}
+
+// artistDeleteByUUID godoc
+// @Tags         Artist API
+// @Summary      Delete artist by UUID
+// @Description  Delete artist by UUID. Artist with audios cannot be deleted
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Artist UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid} [delete]
+func (h *Handler) artistDeleteByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Artist.Delete(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "artist has audios")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete artist by uuid")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "artist deleted correctly")
+}
+
+// artistAudiosList godoc
+// @Tags         Artist API
+// @Summary      List artist audios by UUID
+// @Description  List artist audios by UUID
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string false "User ID, audios are marked with is_favorite"
+// @Param uuid path string false "Artist UUID"
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseAudioRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid}/audios [get]
+func (h *Handler) artistAudiosList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	audios, err := h.s.Artist.ListAudios(uuid, pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list artist audios")
+		return
+	}
+
+	audioSchemas := make([]schema.ResponseAudioRead, 0, len(audios))
+	for i := 0; i < len(audios); i++ {
+		a := schema.ResponseAudioRead{}
+		a.FromDTO(&audios[i])
+		audioSchemas = append(audioSchemas, a)
+	}
+
+	err = h.markFavorites(r, len(audioSchemas), func(i int) *schema.ResponseAudioRead { return &audioSchemas[i] })
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find favorites")
+		return
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, audioSchemas, "audios got correctly")
+}
+
+// artistMerge godoc
+// @Tags         Artist API
+// @Summary      Merge artist into artist by UUID
+// @Description  Merge source artist into artist by UUID. Source audios, albums and aliases are moved, source name is kept as alias and source is deleted
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string false "Editor id"
+// @Param uuid path string false "Target artist UUID"
+// @Param Merge body schema.RequestArtistMerge false "Source artist"
+// @Success      200  {object}  ResponseBase[schema.ResponseArtistRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid}/merge [post]
+func (h *Handler) artistMerge(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	merge := schema.RequestArtistMerge{}
+	err = json.NewDecoder(r.Body).Decode(&merge)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	sourceUUID, err := merge.ToUUID()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	artist, err := h.s.Artist.Merge(uuid, sourceUUID, h.getUserID(r))
+	if err != nil {
+		if errors.Is(err, artistService.ErrMergeSelf) {
+			WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on merge artists")
+		return
+	}
+
+	artistSchema := schema.ResponseArtistRead{}
+	artistSchema.FromDTO(artist)
+
+	WriteResponse(w, http.StatusOK, artistSchema, "artists merged correctly")
+}
+
+// artistAliasList godoc
+// @Tags         Artist API
+// @Summary      List artist aliases by UUID
+// @Description  List artist aliases by UUID
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Artist UUID"
+// @Success      200  {object}  ResponseBase[[]schema.ResponseArtistAliasRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid}/aliases [get]
+func (h *Handler) artistAliasList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	aliases, err := h.s.Artist.ListAliases(uuid)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list artist aliases")
+		return
+	}
+
+	aliasSchemas := make([]schema.ResponseArtistAliasRead, 0, len(aliases))
+	for i := 0; i < len(aliases); i++ {
+		a := schema.ResponseArtistAliasRead{}
+		a.FromDTO(&aliases[i])
+		aliasSchemas = append(aliasSchemas, a)
+	}
+
+	WriteResponse(w, http.StatusOK, aliasSchemas, "aliases got correctly")
+}
+
+// artistAliasCreate godoc
+// @Tags         Artist API
+// @Summary      Create artist alias
+// @Description  Create artist alias. Alias is matched after name canonicalization and must be unique across artists
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Artist UUID"
+// @Param Alias body schema.RequestArtistAliasCreate false "Alias base"
+// @Success      201  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid}/aliases [post]
+func (h *Handler) artistAliasCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	alias := schema.RequestArtistAliasCreate{}
+	err = json.NewDecoder(r.Body).Decode(&alias)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	aliasDTO, err := alias.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	aliasUUID, err := h.s.Artist.CreateAlias(uuid, aliasDTO)
+	if err != nil {
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "alias already exists")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "artist does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "create alias err")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: aliasUUID}, "alias created correctly")
+}
+
+// artistAliasDelete godoc
+// @Tags         Artist API
+// @Summary      Delete artist alias by UUID
+// @Description  Delete artist alias by UUID
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Artist UUID"
+// @Param alias_uuid path string false "Alias UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /artists/{uuid}/aliases/{alias_uuid} [delete]
+func (h *Handler) artistAliasDelete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	aliasUUID, err := h.getNamedUUIDParam(ps, "alias_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Artist.DeleteAlias(uuid, aliasUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete artist alias")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: aliasUUID}, "alias deleted correctly")
+}
@@ -0,0 +1,265 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_artistCreate(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIArtistService, artist *dto.ArtistCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.ArtistCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_valid_input",
+			inputBody: `{"name": " The Beatles ", "country": "GB"}`,
+			inputDTO:  &dto.ArtistCreate{Name: "The Beatles", Country: "GB"},
+			mockBehaviour: func(s *mockservice.MockIArtistService, artist *dto.ArtistCreate) {
+				s.EXPECT().Create(artist).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"artist created correctly"}`,
+		},
+		{
+			name:          "400_empty_name",
+			inputBody:     `{"name": " "}`,
+			mockBehaviour: func(s *mockservice.MockIArtistService, artist *dto.ArtistCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'name' is required and cannot be empty;", "message":"validation err"}`,
+		},
+		{
+			name:      "409_already_exists",
+			inputBody: `{"name": "The Beatles"}`,
+			inputDTO:  &dto.ArtistCreate{Name: "The Beatles"},
+			mockBehaviour: func(s *mockservice.MockIArtistService, artist *dto.ArtistCreate) {
+				s.EXPECT().Create(artist).Return(pgtype.UUID{}, fmt.Errorf("%w: name", crud.ErrUniqueViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"already exists: name", "message":"artist already exists"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			artistService := mockservice.NewMockIArtistService(c)
+			testCase.mockBehaviour(artistService, testCase.inputDTO)
+
+			services := service.Service{Artist: artistService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/artists", handler.artistCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/artists", strings.NewReader(testCase.inputBody))
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_artistDeleteByUUID(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIArtistService, uuid pgtype.UUID)
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		inputUUID     pgtype.UUID
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_valid_uuid",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIArtistService, uuid pgtype.UUID) {
+				s.EXPECT().Delete(uuid).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"artist deleted correctly"}`,
+		},
+		{
+			name:          "200_no_rows",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIArtistService, uuid pgtype.UUID) {
+				s.EXPECT().Delete(uuid).Return(pgx.ErrNoRows)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{}, "message":"no rows deleted"}`,
+		},
+		{
+			name:          "409_has_audios",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIArtistService, uuid pgtype.UUID) {
+				s.EXPECT().Delete(uuid).Return(fmt.Errorf("%w: audios", crud.ErrForeignKeyViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"referenced by other rows: audios", "message":"artist has audios"}`,
+		},
+		{
+			name:          "500_unknown_error",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIArtistService, uuid pgtype.UUID) {
+				s.EXPECT().Delete(uuid).Return(errors.New("unknown error"))
+			},
+			expectedCode: 500,
+			expectedBody: `{"error":"unknown error", "message":"error on delete artist by uuid"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			artistService := mockservice.NewMockIArtistService(c)
+			testCase.mockBehaviour(artistService, testCase.inputUUID)
+
+			services := service.Service{Artist: artistService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.DELETE("/artists/:uuid", handler.artistDeleteByUUID)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("DELETE", "/artists/"+testCase.inputPathUUID, nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_artistMerge(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIArtistService, target, source pgtype.UUID)
+
+	sourceUUID := pgtype.UUID{Bytes: [16]byte{15: 1}, Valid: true}
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		inputBody     string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_merged",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputBody:     `{"source_uuid": "00000000-0000-0000-0000-000000000001"}`,
+			mockBehaviour: func(s *mockservice.MockIArtistService, target, source pgtype.UUID) {
+				s.EXPECT().Merge(target, source, "").Return(&dto.ArtistRead{UUID: target, Name: "Kino"}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{"uuid":"00000000-0000-0000-0000-000000000000","name":"Kino","country":"","description":"","created_at":null,"updated_at":null}, "message":"artists merged correctly"}`,
+		},
+		{
+			// albums of source are moved to target before source is deleted
+			name:          "200_merged_source_with_album",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputBody:     `{"source_uuid": "00000000-0000-0000-0000-000000000001"}`,
+			mockBehaviour: func(s *mockservice.MockIArtistService, target, source pgtype.UUID) {
+				s.EXPECT().Merge(target, source, "").Return(&dto.ArtistRead{UUID: target, Name: "Kino"}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{"uuid":"00000000-0000-0000-0000-000000000000","name":"Kino","country":"","description":"","created_at":null,"updated_at":null}, "message":"artists merged correctly"}`,
+		},
+		{
+			name:          "400_invalid_source",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputBody:     `{"source_uuid": "kino"}`,
+			mockBehaviour: func(s *mockservice.MockIArtistService, target, source pgtype.UUID) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'source_uuid' must be valid uuid;", "message":"validation error"}`,
+		},
+		{
+			name:          "200_no_rows",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputBody:     `{"source_uuid": "00000000-0000-0000-0000-000000000001"}`,
+			mockBehaviour: func(s *mockservice.MockIArtistService, target, source pgtype.UUID) {
+				s.EXPECT().Merge(target, source, "").Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{}, "message":"no rows updated"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			artistService := mockservice.NewMockIArtistService(c)
+			testCase.mockBehaviour(artistService, pgtype.UUID{Valid: true}, sourceUUID)
+
+			services := service.Service{Artist: artistService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/artists/:uuid/merge", handler.artistMerge)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/artists/"+testCase.inputPathUUID+"/merge", strings.NewReader(testCase.inputBody))
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"eMobile/internal/crud"
 	"eMobile/internal/dto"
 	"eMobile/internal/schema"
+	"eMobile/internal/service/audioService"
+	"eMobile/pkg/playlistfile"
 	"encoding/json"
 	"errors"
 	"github.com/julienschmidt/httprouter"
@@ -19,21 +21,36 @@ func (h *Handler) initAudioHandler(r *httprouter.Router) {
 	r.DELETE("/api/v1/audios/:uuid", h.audioDeleteByUUID)
 
 	r.GET("/api/v1/audios/:uuid/lyrics", h.audioLyricsList)
+	r.GET("/api/v1/audios/:uuid/provenance", h.audioProvenanceList)
+	r.GET("/api/v1/audios/:uuid/analysis", h.audioAnalysis)
+	r.POST("/api/v1/audios/:uuid/refresh", h.audioRefresh)
 
 }
 
 // audioCreate godoc
 // @Tags         Audio API
 // @Summary      Create audio
-// @Description  Create audio
-// @Accept       json
+// @Description  Create audio. Omitted release_date, link and lyrics are fetched from the info service.
+// @Description  With source "manual" the info service is not called and release_date and link are required.
+// @Description  Body with audio/* content type is mp3, flac or ogg file: audio is created from its tags
+// @Description  with manual source, file is attached and response data is schema.ResponseAudioFileUpload
+// @Accept       json,audio/mpeg,audio/flac,audio/ogg
 // @Produce      json
+// @Param X-User-ID header string false "Editor id"
 // @Param Audio body schema.RequestAudioCreate false "Audio base"
 // @Success      201  {object}  ResponseBase[schema.ResponseUUID]
 // @Failure      400  {object}  ResponseBaseErr
+// @Failure      404  {object}  ResponseBaseErr
+// @Failure      413  {object}  ResponseBaseErr
+// @Failure      415  {object}  ResponseBaseErr
 // @Failure      500  {object}	ResponseBaseErr
 // @Router       /audios [post]
 func (h *Handler) audioCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if isAudioUpload(r) {
+		h.audioCreateFromFile(w, r)
+		return
+	}
+
 	audio := schema.RequestAudioCreate{}
 
 	err := json.NewDecoder(r.Body).Decode(&audio)
@@ -47,28 +64,48 @@ func (h *Handler) audioCreate(w http.ResponseWriter, r *http.Request, ps httprou
 		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
 		return
 	}
+	audioDTO.Editor = h.getUserID(r)
 
 	uuid, err := h.s.Audio.Create(audioDTO)
 	if err != nil {
+		if errors.Is(err, audioService.ErrAudioInfoNotFound) {
+			WriteResponseErr(w, http.StatusNotFound, err, "audio info not found")
+			return
+		}
 		WriteResponseErr(w, http.StatusInternalServerError, err, "create audio err")
 		return
 	}
 
-	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{uuid}, "audio created correctly")
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: uuid}, "audio created correctly")
 }
 
 // audioList godoc
 // @Tags         Audio API
 // @Summary      List audio by Filter
-// @Description  List audio by Filter
+// @Description  List audio by Filter. Accept audio/x-mpegurl returns all matched audios as extended M3U playlist,
+// @Description  application/xspf+xml as XSPF playlist, limit and offset are ignored. Audios without link are left out of M3U.
+// @Description  Playlist is cut after 10000 audios with X-Export-Truncated: true header
 // @Accept       json
-// @Produce      json
+// @Produce      json,audio/x-mpegurl,application/xspf+xml
+// @Param X-User-ID header string false "User ID, audios are marked with is_favorite"
 // @Param group 	query string false "exact search"
 // @Param song 		query string false "full-text-search (english)"
-// @Param after 	query string false "after(include) search"
-// @Param before 	query string false "before(include) search"
-// @Param link 		query string false "exact search"
+// @Param after 	query string false "after(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods"
+// @Param before 	query string false "before(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods"
+// @Param link 		query string false "any link of audio, matched in canonical form"
 // @Param lyric 	query string false "full-text-search (english)"
+// @Param credit 	query string false "credited person, matched by canonical name"
+// @Param credit_role 	query string false "credit role, requires credit" Enums(performer, featured, writer, composer, lyricist, producer)
+// @Param genre 	query []string false "genre slug, subgenres match too" collectionFormat(multi)
+// @Param genre_mode 	query string false "match any or all genres" Enums(any, all)
+// @Param tag 		query []string false "tag" collectionFormat(multi)
+// @Param tag_mode 	query string false "match any or all tags" Enums(any, all)
+// @Param platform 	query string false "has link on platform" Enums(youtube, spotify, apple, yandex, soundcloud, other)
+// @Param duration_min 	query int false "min duration (include), milliseconds"
+// @Param duration_max 	query int false "max duration (include), milliseconds"
+// @Param isrc 		query string false "exact search, hyphens allowed"
+// @Param explicit 	query boolean false "explicit content or not"
+// @Param sort 		query string false "sort key, '-' prefix sorts descending" Enums(play_count, -play_count, created_at, -created_at, release_date, -release_date)
 // @Param limit 	query int false "rows limit"
 // @Param offset 	query int false "rows offset"
 // @Success      200  {object}  ResponseBasePaginated[schema.ResponseAudioRead]
@@ -86,6 +123,24 @@ func (h *Handler) audioList(w http.ResponseWriter, r *http.Request, ps httproute
 
 	pag := h.getPagination(r.URL)
 
+	if contentType := exportContentType(r); contentType != "" {
+		audios, truncated, err := h.exportAudios(filterDTO)
+		if err != nil {
+			WriteResponseErr(w, http.StatusInternalServerError, err, "error on list audios")
+			return
+		}
+		if truncated {
+			w.Header().Set("X-Export-Truncated", "true")
+		}
+
+		tracks := make([]playlistfile.Track, 0, len(audios))
+		for i := 0; i < len(audios); i++ {
+			tracks = append(tracks, audioTrack(&audios[i]))
+		}
+		h.writePlaylistFile(w, contentType, "audios", tracks)
+		return
+	}
+
 	var audios []dto.AudioRead
 	if filterDTO == nil {
 		audios, err = h.s.Audio.ListPag(pag)
@@ -114,6 +169,12 @@ func (h *Handler) audioList(w http.ResponseWriter, r *http.Request, ps httproute
 		audioSchemas = append(audioSchemas, a)
 	}
 
+	err = h.markFavorites(r, len(audioSchemas), func(i int) *schema.ResponseAudioRead { return &audioSchemas[i] })
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find favorites")
+		return
+	}
+
 	WriteResponsePaginated(w, http.StatusOK, nextPag, audioSchemas, "audios got correctly")
 }
 
@@ -124,7 +185,7 @@ func (h *Handler) audioList(w http.ResponseWriter, r *http.Request, ps httproute
 // @Accept       json
 // @Produce      json
 // @Param uuid path string false "Audio UUID"
-// @Param full query boolean false "With lyrics or not"
+// @Param full query boolean false "With lyrics, album, credits, genres, tags and links or not"
 // @Success      200  {object}  ResponseBase[schema.ResponseAudioReadFull]
 // @Failure      400  {object}  ResponseBaseErr
 // @Failure      500  {object}	ResponseBaseErr
@@ -171,6 +232,7 @@ func (h *Handler) audioFindByUUID(w http.ResponseWriter, r *http.Request, ps htt
 // @Accept       json
 // @Produce      json
 // @Param uuid path string false "Audio UUID"
+// @Param X-User-ID header string false "Editor id"
 // @Param Audio body schema.RequestAudioUpdate false "Audio update base"
 // @Success      200  {object}  ResponseBase[schema.ResponseAudioRead]
 // @Failure      400  {object}  ResponseBaseErr
@@ -195,6 +257,7 @@ func (h *Handler) audioUpdateByUUID(w http.ResponseWriter, r *http.Request, ps h
 		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
 		return
 	}
+	audioDTO.Editor = h.getUserID(r)
 
 	readAudioDTO, err := h.s.Audio.Update(uuid, audioDTO)
 	if err != nil {
@@ -215,7 +278,7 @@ func (h *Handler) audioUpdateByUUID(w http.ResponseWriter, r *http.Request, ps h
 // audioDeleteByUUID godoc
 // @Tags         Audio API
 // @Summary      Delete audio by UUID
-// @Description  Delete audio by UUID
+// @Description  Delete audio by UUID. Audio is removed from playlists, following entries are shifted
 // @Accept       json
 // @Produce      json
 // @Param uuid path string false "Audio UUID"
@@ -240,7 +303,7 @@ func (h *Handler) audioDeleteByUUID(w http.ResponseWriter, r *http.Request, ps h
 		return
 	}
 
-	WriteResponse(w, http.StatusOK, schema.ResponseUUID{uuid}, "audio deleted correctly")
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "audio deleted correctly")
 }
 
 // audioLyricsList godoc
@@ -287,3 +350,110 @@ func (h *Handler) audioLyricsList(w http.ResponseWriter, r *http.Request, ps htt
 	}
 	WriteResponsePaginated(w, http.StatusOK, nextPag, lyricSchemas, "lyrics got correctly")
 }
+
+// audioProvenanceList godoc
+// @Tags         Audio API
+// @Summary      List audio fields provenance by UUID
+// @Description  List source, fetch time and editor of each audio field
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[[]schema.ResponseProvenanceRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/provenance [get]
+func (h *Handler) audioProvenanceList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	provenance, err := h.s.Audio.ListProvenance(uuid)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list audio provenance")
+		return
+	}
+
+	provenanceSchemas := make([]schema.ResponseProvenanceRead, 0, len(provenance))
+	for i := 0; i < len(provenance); i++ {
+		p := schema.ResponseProvenanceRead{}
+		p.FromDTO(&provenance[i])
+		provenanceSchemas = append(provenanceSchemas, p)
+	}
+	WriteResponse(w, http.StatusOK, provenanceSchemas, "provenance got correctly")
+}
+
+// audioAnalysis godoc
+// @Tags         Audio API
+// @Summary      Analyze audio lyrics by UUID
+// @Description  Count verses, lines and words of audio lyrics. Top words are most frequent words
+// @Description  without english and russian stop words, repetition_score is share of lines repeating
+// @Description  an earlier line, e.g. chorus
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseLyricAnalysis]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/analysis [get]
+func (h *Handler) audioAnalysis(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	analysis, err := h.s.Audio.Analyze(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows find")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on analyze audio lyrics")
+		return
+	}
+
+	analysisSchema := schema.ResponseLyricAnalysis{}
+	analysisSchema.FromDTO(analysis)
+	WriteResponse(w, http.StatusOK, analysisSchema, "analysis got correctly")
+}
+
+// audioRefresh godoc
+// @Tags         Audio API
+// @Summary      Refresh audio from info service
+// @Description  Fetch audio info again and update fields not edited manually
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseAudioRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      404  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/refresh [post]
+func (h *Handler) audioRefresh(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	readAudioDTO, err := h.s.Audio.Refresh(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows find")
+			return
+		}
+		if errors.Is(err, audioService.ErrAudioInfoNotFound) {
+			WriteResponseErr(w, http.StatusNotFound, err, "audio info not found")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on refresh audio")
+		return
+	}
+
+	readAudioSchema := schema.ResponseAudioRead{}
+	readAudioSchema.FromDTO(readAudioDTO)
+
+	WriteResponse(w, http.StatusOK, readAudioSchema, "audio refreshed correctly")
+}
@@ -6,8 +6,10 @@ import (
 	"eMobile/internal/crud"
 	"eMobile/internal/dto"
 	"eMobile/internal/service"
+	"eMobile/internal/service/audioService"
 	mockservice "eMobile/internal/service/mocks"
 	"eMobile/pkg/logging"
+	"eMobile/pkg/lyrics"
 	"errors"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -47,8 +49,9 @@ func TestHandler_audioCreate(t *testing.T) {
 							"song": "Some song"
 						}`,
 			inputDTO: &dto.AudioCreate{
-				Group: "classic",
-				Song:  "Some song",
+				Group:  "classic",
+				Song:   "Some song",
+				Source: dto.SourceInfo,
 			},
 			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
 				s.EXPECT().Create(audio).Return(
@@ -59,6 +62,119 @@ func TestHandler_audioCreate(t *testing.T) {
 			expectedCode: 201,
 			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"audio created correctly"}`,
 		},
+		{
+			name: "201_valid_manual_input",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song",
+							"release_date": "2012-09-23",
+							"link": "https://youtu.be/dQw4w9WgXcQ",
+							"lyrics": "lyric1\n\nlyric2",
+							"source": "manual"
+						}`,
+			inputDTO: &dto.AudioCreate{
+				Group:                "classic",
+				Song:                 "Some song",
+				ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2012-09-23"), Valid: true},
+				ReleaseDatePrecision: dto.PrecisionDay,
+				Link:                 sql.NullString{String: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", Valid: true},
+				LyricsRaw:            sql.NullString{String: "lyric1\n\nlyric2", Valid: true},
+				Source:               dto.SourceManual,
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+				s.EXPECT().Create(audio).Return(
+					pgtype.UUID{Valid: true},
+					nil,
+				)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"audio created correctly"}`,
+		},
+		{
+			name: "201_technical_metadata",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song",
+							"duration_ms": 213000,
+							"isrc": "gb-aye-87-00123",
+							"bpm": 113,
+							"key": "G#m",
+							"explicit": true
+						}`,
+			inputDTO: &dto.AudioCreate{
+				Group:      "classic",
+				Song:       "Some song",
+				DurationMs: pgtype.Int4{Int32: 213000, Valid: true},
+				ISRC:       pgtype.Text{String: "GBAYE8700123", Valid: true},
+				BPM:        pgtype.Float8{Float64: 113, Valid: true},
+				Key:        pgtype.Text{String: "G# minor", Valid: true},
+				Explicit:   pgtype.Bool{Bool: true, Valid: true},
+				Source:     dto.SourceInfo,
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+				s.EXPECT().Create(audio).Return(
+					pgtype.UUID{Valid: true},
+					nil,
+				)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"audio created correctly"}`,
+		},
+		{
+			name: "400_invalid_technical_metadata",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song",
+							"duration_ms": 0,
+							"isrc": "GBAYE870012",
+							"bpm": 1000,
+							"key": "H"
+						}`,
+			inputDTO: nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+			},
+			expectedCode: 400,
+			expectedBody: `{"error":"'duration_ms' must be between 1 and 86400000;'isrc' must be 12 characters: country, registrant, year and designation code, example: GB-AYE-87-00123;'bpm' must be greater than 0 and less than 1000;'key' must be note with optional accidental and mode, example: C# minor;", "message":"validation err"}`,
+		},
+		{
+			name: "400_manual_without_required",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song",
+							"source": "manual"
+						}`,
+			inputDTO: nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+			},
+			expectedCode: 400,
+			expectedBody: `{"error":"'release_date' is required for manual source;'link' is required for manual source;", "message":"validation err"}`,
+		},
+		{
+			name: "400_invalid_link",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song",
+							"link": "javascript:alert(1)"
+						}`,
+			inputDTO: nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+			},
+			expectedCode: 400,
+			expectedBody: `{"error":"'link' must be absolute http or https url;", "message":"validation err"}`,
+		},
+		{
+			name: "400_invalid_source",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song",
+							"source": "radio"
+						}`,
+			inputDTO: nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+			},
+			expectedCode: 400,
+			expectedBody: `{"error":"'source' must be one of: info, manual;", "message":"validation err"}`,
+		},
 		{
 			name: "400_invalid_values",
 			inputBody: `{
@@ -88,6 +204,26 @@ func TestHandler_audioCreate(t *testing.T) {
 			expectedCode: 400,
 			expectedBody: `{"error":"invalid character '}' looking for beginning of object key string", "message":"read body err"}`,
 		},
+		{
+			name: "404_audio_info_not_found",
+			inputBody: `{
+							"group": "classic",
+							"song": "Some song"
+						}`,
+			inputDTO: &dto.AudioCreate{
+				Group:  "classic",
+				Song:   "Some song",
+				Source: dto.SourceInfo,
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
+				s.EXPECT().Create(audio).Return(
+					pgtype.UUID{},
+					audioService.ErrAudioInfoNotFound,
+				)
+			},
+			expectedCode: 404,
+			expectedBody: `{"error":"audio info not found", "message":"audio info not found"}`,
+		},
 		{
 			name: "500_unknown_err",
 			inputBody: `{
@@ -95,8 +231,9 @@ func TestHandler_audioCreate(t *testing.T) {
 							"song": "Some song"
 						}`,
 			inputDTO: &dto.AudioCreate{
-				Group: "classic",
-				Song:  "Some song",
+				Group:  "classic",
+				Song:   "Some song",
+				Source: dto.SourceInfo,
 			},
 			mockBehaviour: func(s *mockservice.MockIAudioService, audio *dto.AudioCreate) {
 				s.EXPECT().Create(audio).Return(
@@ -166,11 +303,12 @@ func TestHandler_audioList(t *testing.T) {
 		{
 			name:       "200_empty_query",
 			inputQuery: "",
-			inputPag:   crud.Pagination{0, 50},
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
 			inputDTO:   nil,
 			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
 				s.EXPECT().ListPag(pag).Return([]dto.AudioRead{{
 					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
 					Group:       "group1",
 					Song:        "song1",
 					ReleaseDate: pgtype.Date{Valid: true},
@@ -180,24 +318,25 @@ func TestHandler_audioList(t *testing.T) {
 				}}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": [{"created_at":"0001-01-01T00:00:00Z", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			expectedBody:    `{"data": [{"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
 			bodyMustContain: "",
 		},
 		{
 			name:       "200_full_query",
-			inputQuery: "?group=group1&song=some%20text&after=2012-09-23&before=2013-09-23&link=link1&lyric=some%20lyric&limit=35&offset=10",
-			inputPag:   crud.Pagination{10, 35},
+			inputQuery: "?group=group1&song=some%20text&after=2012-09-23&before=2013-09-23&link=https%3A%2F%2Fyoutu.be%2FdQw4w9WgXcQ%3Ft%3D1&lyric=some%20lyric&limit=35&offset=10",
+			inputPag:   crud.Pagination{Offset: 10, Limit: 35},
 			inputDTO: &dto.AudioFilter{
 				Group:             sql.NullString{String: "group1", Valid: true},
 				Song:              sql.NullString{String: "some text", Valid: true},
 				ReleaseDateAfter:  pgtype.Date{Time: parseTime("2006-01-02", "2012-09-23"), Valid: true},
 				ReleaseDateBefore: pgtype.Date{Time: parseTime("2006-01-02", "2013-09-23"), Valid: true},
-				Link:              sql.NullString{String: "link1", Valid: true},
+				Link:              sql.NullString{String: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", Valid: true},
 				Lyric:             sql.NullString{String: "some lyric", Valid: true},
 			},
 			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
 				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{{
 					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
 					Group:       "group1",
 					Song:        "song1",
 					ReleaseDate: pgtype.Date{Valid: true},
@@ -207,24 +346,188 @@ func TestHandler_audioList(t *testing.T) {
 				}}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": [{"created_at":"0001-01-01T00:00:00Z", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}], "message":"audios got correctly", "next_pagination":{"limit":35, "offset":45}}`,
+			expectedBody:    `{"data": [{"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}], "message":"audios got correctly", "next_pagination":{"limit":35, "offset":45}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "200_credit_query",
+			inputQuery: "?credit=Paul%20McCartney&credit_role=writer",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO: &dto.AudioFilter{
+				Credit:     sql.NullString{String: "Paul McCartney", Valid: true},
+				CreditRole: sql.NullString{String: "writer", Valid: true},
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": [], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "200_genre_tag_query",
+			inputQuery: "?genre=Post-Punk,rock&genre_mode=all&tag=Summer%20Hits&tag=running",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO: &dto.AudioFilter{
+				Genres:         []string{"post-punk", "rock"},
+				GenresMatchAll: true,
+				Tags:           []string{"summer hits", "running"},
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": [], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "200_platform_query",
+			inputQuery: "?platform=spotify",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO: &dto.AudioFilter{
+				Platform: sql.NullString{String: "spotify", Valid: true},
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": [], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "400_invalid_platform",
+			inputQuery: "?platform=vk",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO:   nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+			},
+			expectedCode:    400,
+			expectedBody:    `{"error":"'platform' must be one of: youtube, spotify, apple, yandex, soundcloud, other", "message":"validation err"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "200_sort_by_play_count",
+			inputQuery: "?sort=-play_count",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO: &dto.AudioFilter{
+				Sort:     sql.NullString{String: dto.SortPlayCount, Valid: true},
+				SortDesc: true,
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{{
+					Group:     "group1",
+					Song:      "song1",
+					PlayCount: 42,
+				}}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": [{"uuid":null, "artist_uuid":null, "group":"group1", "song":"song1", "release_date":null, "link":"", "play_count":42, "created_at":null, "updated_at":null}], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "400_invalid_sort",
+			inputQuery: "?sort=song",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO:   nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+			},
+			expectedCode:    400,
+			expectedBody:    `{"error":"'sort' must be one of: play_count, created_at, release_date, '-' prefix sorts descending", "message":"validation err"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "200_technical_query",
+			inputQuery: "?duration_min=180000&duration_max=300000&isrc=GB-AYE-87-00123&explicit=false",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO: &dto.AudioFilter{
+				DurationMin: pgtype.Int4{Int32: 180000, Valid: true},
+				DurationMax: pgtype.Int4{Int32: 300000, Valid: true},
+				ISRC:        sql.NullString{String: "GBAYE8700123", Valid: true},
+				Explicit:    pgtype.Bool{Bool: false, Valid: true},
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{{
+					Group:      "group1",
+					Song:       "song1",
+					Link:       "link1",
+					DurationMs: pgtype.Int4{Int32: 213000, Valid: true},
+					ISRC:       pgtype.Text{String: "GBAYE8700123", Valid: true},
+					Key:        pgtype.Text{String: "Ab major", Valid: true},
+				}}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": [{"created_at":null, "artist_uuid":null, "group":"group1", "link":"link1", "release_date":null, "song":"song1", "duration_ms":213000, "isrc":"GBAYE8700123", "key":"Ab major", "updated_at":null, "uuid":null}], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "400_duration_min_greater_than_max",
+			inputQuery: "?duration_min=300000&duration_max=180000",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO:   nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+			},
+			expectedCode:    400,
+			expectedBody:    `{"error":"'duration_min' cannot be greater than 'duration_max'", "message":"validation err"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "200_partial_date_query",
+			inputQuery: "?after=2012&before=2013-02",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO: &dto.AudioFilter{
+				ReleaseDateAfter:  pgtype.Date{Time: parseTime("2006-01-02", "2012-01-01"), Valid: true},
+				ReleaseDateBefore: pgtype.Date{Time: parseTime("2006-01-02", "2013-02-28"), Valid: true},
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+				s.EXPECT().ListByFilter(filter, pag).Return([]dto.AudioRead{{
+					Group:                "group1",
+					Song:                 "song1",
+					ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2012-09-01"), Valid: true},
+					ReleaseDatePrecision: dto.PrecisionMonth,
+					Link:                 "link1",
+				}}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": [{"created_at":null, "artist_uuid":null, "group":"group1", "link":"link1", "release_date":"2012-09", "song":"song1", "updated_at":null, "uuid":null}], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "400_invalid_tag_mode",
+			inputQuery: "?tag=running&tag_mode=some",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO:   nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+			},
+			expectedCode:    400,
+			expectedBody:    `{"error":"'tag_mode' must be one of: any, all", "message":"validation err"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:       "400_credit_role_without_credit",
+			inputQuery: "?credit_role=writer",
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
+			inputDTO:   nil,
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
+			},
+			expectedCode:    400,
+			expectedBody:    `{"error":"'credit_role' requires 'credit'", "message":"validation err"}`,
 			bodyMustContain: "",
 		},
 		{
 			name:       "400_invalid_query_date",
 			inputQuery: "?after=2012-19-23&before=2013-19-23",
-			inputPag:   crud.Pagination{0, 50},
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
 			inputDTO:   nil,
 			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
 			},
 			expectedCode:    400,
-			expectedBody:    `{"error":"invalid after format, example: 2006-09-25", "message":"validation err"}`,
+			expectedBody:    `{"error":"invalid after format, examples: 2006, 2006-09, 2006-09-25", "message":"validation err"}`,
 			bodyMustContain: "",
 		},
 		{
 			name:       "200_no_rows",
 			inputQuery: "",
-			inputPag:   crud.Pagination{0, 50},
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
 			inputDTO:   nil,
 			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
 				s.EXPECT().ListPag(pag).Return(nil, pgx.ErrNoRows)
@@ -236,7 +539,7 @@ func TestHandler_audioList(t *testing.T) {
 		{
 			name:       "500_unknown_error",
 			inputQuery: "",
-			inputPag:   crud.Pagination{0, 50},
+			inputPag:   crud.Pagination{Offset: 0, Limit: 50},
 			inputDTO:   nil,
 			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter, pag crud.Pagination) {
 				s.EXPECT().ListPag(pag).Return(nil, errors.New("unknown error"))
@@ -313,6 +616,7 @@ func TestHandler_audioFindByUUID(t *testing.T) {
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
 				s.EXPECT().Find(uuid).Return(&dto.AudioRead{
 					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
 					Group:       "group1",
 					Song:        "song1",
 					ReleaseDate: pgtype.Date{Valid: true},
@@ -322,7 +626,7 @@ func TestHandler_audioFindByUUID(t *testing.T) {
 				}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
+			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
 			bodyMustContain: "",
 		},
 		{
@@ -332,6 +636,7 @@ func TestHandler_audioFindByUUID(t *testing.T) {
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
 				s.EXPECT().Find(uuid).Return(&dto.AudioRead{
 					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
 					Group:       "group1",
 					Song:        "song1",
 					ReleaseDate: pgtype.Date{Valid: true},
@@ -341,7 +646,7 @@ func TestHandler_audioFindByUUID(t *testing.T) {
 				}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
+			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
 			bodyMustContain: "",
 		},
 		{
@@ -351,11 +656,16 @@ func TestHandler_audioFindByUUID(t *testing.T) {
 			inputUUID:     pgtype.UUID{Valid: true},
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
 				s.EXPECT().FindWithLyric(uuid).Return(&dto.AudioReadFull{
-					UUID:        pgtype.UUID{Valid: true},
-					Group:       "group1",
-					Song:        "song1",
-					ReleaseDate: pgtype.Date{Valid: true},
-					Link:        "link1",
+					AudioRead: dto.AudioRead{
+						UUID:        pgtype.UUID{Valid: true},
+						ArtistUUID:  pgtype.UUID{Valid: true},
+						Group:       "group1",
+						Song:        "song1",
+						ReleaseDate: pgtype.Date{Valid: true},
+						Link:        "link1",
+						CreatedAt:   pgtype.Timestamptz{Valid: true},
+						UpdatedAt:   pgtype.Timestamptz{Valid: true},
+					},
 					Lyrics: []dto.LyricRead{
 						{
 							UUID:      pgtype.UUID{Valid: true},
@@ -374,12 +684,72 @@ func TestHandler_audioFindByUUID(t *testing.T) {
 							UpdatedAt: pgtype.Timestamptz{Valid: true},
 						},
 					},
-					CreatedAt: pgtype.Timestamptz{Valid: true},
-					UpdatedAt: pgtype.Timestamptz{Valid: true},
 				}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "group":"group1", "link":"link1", "release_date":"0001-01-01", "lyrics": [{"audio_uuid":"00000000-0000-0000-0000-000000000000", "created_at":"0001-01-01T00:00:00Z", "order":0, "text":"lyric1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, {"audio_uuid":"00000000-0000-0000-0000-000000000000", "created_at":"0001-01-01T00:00:00Z", "order":1, "text":"lyric2", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}], "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
+			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "lyrics": [{"audio_uuid":"00000000-0000-0000-0000-000000000000", "created_at":"0001-01-01T00:00:00Z", "order":0, "text":"lyric1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, {"audio_uuid":"00000000-0000-0000-0000-000000000000", "created_at":"0001-01-01T00:00:00Z", "order":1, "text":"lyric2", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}], "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:          "200_valid_uuid_with_explicit_verses",
+			inputQueryRaw: "?full=true",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().FindWithLyric(uuid).Return(&dto.AudioReadFull{
+					AudioRead: dto.AudioRead{
+						UUID:     pgtype.UUID{Valid: true},
+						Group:    "group1",
+						Song:     "song1",
+						Explicit: true,
+					},
+					Lyrics: []dto.LyricRead{
+						{
+							UUID:  pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+							Order: 0,
+							Text:  "lyric1",
+						},
+						{
+							UUID:     pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+							Order:    1,
+							Text:     "lyric2",
+							Explicit: true,
+						},
+					},
+				}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": {"created_at":null, "artist_uuid":null, "group":"group1", "link":"", "release_date":null, "explicit":true, "lyrics": [{"audio_uuid":null, "created_at":null, "order":0, "text":"lyric1", "updated_at":null, "uuid":"01000000-0000-0000-0000-000000000000"}, {"audio_uuid":null, "created_at":null, "order":1, "text":"lyric2", "explicit":true, "updated_at":null, "uuid":"02000000-0000-0000-0000-000000000000"}], "explicit_verses":["02000000-0000-0000-0000-000000000000"], "song":"song1", "updated_at":null, "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:          "200_valid_uuid_with_album",
+			inputQueryRaw: "?full=true",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().FindWithLyric(uuid).Return(&dto.AudioReadFull{
+					AudioRead: dto.AudioRead{
+						UUID:        pgtype.UUID{Valid: true},
+						ArtistUUID:  pgtype.UUID{Valid: true},
+						Group:       "group1",
+						Song:        "song1",
+						ReleaseDate: pgtype.Date{Valid: true},
+						Link:        "link1",
+						CreatedAt:   pgtype.Timestamptz{Valid: true},
+						UpdatedAt:   pgtype.Timestamptz{Valid: true},
+					},
+					Album: &dto.AudioAlbum{
+						UUID:        pgtype.UUID{Valid: true},
+						Title:       "album1",
+						Type:        dto.AlbumTypeEP,
+						ReleaseDate: pgtype.Date{Valid: true},
+						Position:    2,
+					},
+				}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "album": {"uuid":"00000000-0000-0000-0000-000000000000", "title":"album1", "type":"ep", "release_date":"0001-01-01", "position":2}, "song":"song1", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio got correctly"}`,
 			bodyMustContain: "",
 		},
 		{
@@ -482,21 +852,23 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 								"group": "group22",
 								"song": "song22",
 								"release_date": "2010-11-12",
-								"link": "link22",
+								"link": "http://m.youtube.com/watch?v=oHg5SJYRHA0",
 								"lyrics": "new lyrics\nsame\n\nsecond_lyric"
 							}`,
 			inputUUID: pgtype.UUID{Valid: true},
 			inputAudio: &dto.AudioUpdate{
-				Group:       sql.NullString{String: "group22", Valid: true},
-				Song:        sql.NullString{String: "song22", Valid: true},
-				ReleaseDate: pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
-				Link:        sql.NullString{String: "link22", Valid: true},
-				LyricsRaw:   sql.NullString{String: "new lyrics\nsame\n\nsecond_lyric", Valid: true},
-				Lyrics:      nil,
+				Group:                sql.NullString{String: "group22", Valid: true},
+				Song:                 sql.NullString{String: "song22", Valid: true},
+				ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
+				ReleaseDatePrecision: dto.PrecisionDay,
+				Link:                 sql.NullString{String: "https://www.youtube.com/watch?v=oHg5SJYRHA0", Valid: true},
+				LyricsRaw:            sql.NullString{String: "new lyrics\nsame\n\nsecond_lyric", Valid: true},
+				Lyrics:               nil,
 			},
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID, audio *dto.AudioUpdate) {
 				s.EXPECT().Update(uuid, audio).Return(&dto.AudioRead{
 					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
 					Group:       "group22",
 					Song:        "song22",
 					ReleaseDate: pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
@@ -506,7 +878,7 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 				}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "group":"group22", "link":"link22", "release_date":"2010-11-12", "song":"song22", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio updated correctly"}`,
+			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group22", "link":"link22", "release_date":"2010-11-12", "song":"song22", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio updated correctly"}`,
 			bodyMustContain: "",
 		},
 		{
@@ -522,6 +894,7 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID, audio *dto.AudioUpdate) {
 				s.EXPECT().Update(uuid, audio).Return(&dto.AudioRead{
 					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
 					Group:       "group22",
 					Song:        "song22",
 					ReleaseDate: pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
@@ -531,7 +904,29 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 				}, nil)
 			},
 			expectedCode:    200,
-			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "group":"group22", "link":"link22", "release_date":"2010-11-12", "song":"song22", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio updated correctly"}`,
+			expectedBody:    `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group22", "link":"link22", "release_date":"2010-11-12", "song":"song22", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio updated correctly"}`,
+			bodyMustContain: "",
+		},
+		{
+			name:          "200_year_release_date",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputBodyRaw:  `{"release_date": "2010"}`,
+			inputUUID:     pgtype.UUID{Valid: true},
+			inputAudio: &dto.AudioUpdate{
+				ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2010-01-01"), Valid: true},
+				ReleaseDatePrecision: dto.PrecisionYear,
+			},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID, audio *dto.AudioUpdate) {
+				s.EXPECT().Update(uuid, audio).Return(&dto.AudioRead{
+					Group:                "group22",
+					Song:                 "song22",
+					ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2010-01-01"), Valid: true},
+					ReleaseDatePrecision: dto.PrecisionYear,
+					Link:                 "link22",
+				}, nil)
+			},
+			expectedCode:    200,
+			expectedBody:    `{"data": {"created_at":null, "artist_uuid":null, "group":"group22", "link":"link22", "release_date":"2010", "song":"song22", "updated_at":null, "uuid":null}, "message":"audio updated correctly"}`,
 			bodyMustContain: "",
 		},
 		{
@@ -541,14 +936,14 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 								"group": "group22",
 								"song": "song22",
 								"release_date": "2010-13-12",
-								"link": "link22",
+								"link": "http://m.youtube.com/watch?v=oHg5SJYRHA0",
 								"lyrics": "new lyrics\nsame\n\nsecond_lyric"
 							}`,
 			inputUUID: pgtype.UUID{Valid: true},
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID, audio *dto.AudioUpdate) {
 			},
 			expectedCode:    400,
-			expectedBody:    `{"error":"invalid date format, example: 2006-09-25;", "message":"validation error"}`,
+			expectedBody:    `{"error":"invalid date format, examples: 2006, 2006-09, 2006-09-25;", "message":"validation error"}`,
 			bodyMustContain: "",
 		},
 		{
@@ -558,7 +953,7 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 								"group": "group22",
 								"song": "song22",
 								"release_date": "2010-03-12",
-								"link": "link22",
+								"link": "http://m.youtube.com/watch?v=oHg5SJYRHA0",
 								"lyrics": "new lyrics\nsame\n\nsecond_lyric"
 							}`,
 			inputUUID: pgtype.UUID{Valid: true},
@@ -575,17 +970,18 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 								"group": "group22",
 								"song": "song22",
 								"release_date": "2010-11-12",
-								"link": "link22",
+								"link": "http://m.youtube.com/watch?v=oHg5SJYRHA0",
 								"lyrics": "new lyrics\nsame\n\nsecond_lyric"
 							}`,
 			inputUUID: pgtype.UUID{Valid: true},
 			inputAudio: &dto.AudioUpdate{
-				Group:       sql.NullString{String: "group22", Valid: true},
-				Song:        sql.NullString{String: "song22", Valid: true},
-				ReleaseDate: pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
-				Link:        sql.NullString{String: "link22", Valid: true},
-				LyricsRaw:   sql.NullString{String: "new lyrics\nsame\n\nsecond_lyric", Valid: true},
-				Lyrics:      nil,
+				Group:                sql.NullString{String: "group22", Valid: true},
+				Song:                 sql.NullString{String: "song22", Valid: true},
+				ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
+				ReleaseDatePrecision: dto.PrecisionDay,
+				Link:                 sql.NullString{String: "https://www.youtube.com/watch?v=oHg5SJYRHA0", Valid: true},
+				LyricsRaw:            sql.NullString{String: "new lyrics\nsame\n\nsecond_lyric", Valid: true},
+				Lyrics:               nil,
 			},
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID, audio *dto.AudioUpdate) {
 				s.EXPECT().Update(uuid, audio).Return(nil, pgx.ErrNoRows)
@@ -601,17 +997,18 @@ func TestHandler_audioUpdateByUUID(t *testing.T) {
 								"group": "group22",
 								"song": "song22",
 								"release_date": "2010-11-12",
-								"link": "link22",
+								"link": "http://m.youtube.com/watch?v=oHg5SJYRHA0",
 								"lyrics": "new lyrics\nsame\n\nsecond_lyric"
 							}`,
 			inputUUID: pgtype.UUID{Valid: true},
 			inputAudio: &dto.AudioUpdate{
-				Group:       sql.NullString{String: "group22", Valid: true},
-				Song:        sql.NullString{String: "song22", Valid: true},
-				ReleaseDate: pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
-				Link:        sql.NullString{String: "link22", Valid: true},
-				LyricsRaw:   sql.NullString{String: "new lyrics\nsame\n\nsecond_lyric", Valid: true},
-				Lyrics:      nil,
+				Group:                sql.NullString{String: "group22", Valid: true},
+				Song:                 sql.NullString{String: "song22", Valid: true},
+				ReleaseDate:          pgtype.Date{Time: parseTime("2006-01-02", "2010-11-12"), Valid: true},
+				ReleaseDatePrecision: dto.PrecisionDay,
+				Link:                 sql.NullString{String: "https://www.youtube.com/watch?v=oHg5SJYRHA0", Valid: true},
+				LyricsRaw:            sql.NullString{String: "new lyrics\nsame\n\nsecond_lyric", Valid: true},
+				Lyrics:               nil,
 			},
 			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID, audio *dto.AudioUpdate) {
 				s.EXPECT().Update(uuid, audio).Return(nil, errors.New("unknown error"))
@@ -917,3 +1314,263 @@ func TestHandler_audioLyricsList(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_audioProvenanceList(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioService, uuid pgtype.UUID)
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		inputUUID     pgtype.UUID
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_valid_uuid",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().ListProvenance(uuid).Return([]dto.ProvenanceRead{
+					{
+						AudioUUID: pgtype.UUID{Valid: true},
+						Field:     dto.FieldLink,
+						Source:    dto.SourceManual,
+						Editor:    "editor1",
+						UpdatedAt: pgtype.Timestamptz{Valid: true},
+					},
+					{
+						AudioUUID: pgtype.UUID{Valid: true},
+						Field:     dto.FieldReleaseDate,
+						Source:    dto.SourceInfo,
+						FetchedAt: pgtype.Timestamptz{Valid: true},
+						UpdatedAt: pgtype.Timestamptz{Valid: true},
+					},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data": [{"field":"link", "source":"manual", "editor":"editor1", "fetched_at":null, "updated_at":"0001-01-01T00:00:00Z"}, {"field":"release_date", "source":"info", "fetched_at":"0001-01-01T00:00:00Z", "updated_at":"0001-01-01T00:00:00Z"}], "message":"provenance got correctly"}`,
+		},
+		{
+			name:          "500_unknown_error",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().ListProvenance(uuid).Return(nil, errors.New("unknown error"))
+			},
+			expectedCode: 500,
+			expectedBody: `{"error":"unknown error", "message":"error on list audio provenance"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			audioService := mockservice.NewMockIAudioService(c)
+			testCase.mockBehaviour(audioService, testCase.inputUUID)
+
+			services := service.Service{Audio: audioService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/audios/:uuid/provenance", handler.audioProvenanceList)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/audios/"+testCase.inputPathUUID+"/provenance", nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_audioRefresh(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioService, uuid pgtype.UUID)
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		inputUUID     pgtype.UUID
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_valid_uuid",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().Refresh(uuid).Return(&dto.AudioRead{
+					UUID:        pgtype.UUID{Valid: true},
+					ArtistUUID:  pgtype.UUID{Valid: true},
+					Group:       "group1",
+					Song:        "song1",
+					ReleaseDate: pgtype.Date{Valid: true},
+					Link:        "link1",
+					Source:      dto.SourceInfo,
+					CreatedAt:   pgtype.Timestamptz{Valid: true},
+					UpdatedAt:   pgtype.Timestamptz{Valid: true},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data": {"created_at":"0001-01-01T00:00:00Z", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "link":"link1", "release_date":"0001-01-01", "song":"song1", "source":"info", "updated_at":"0001-01-01T00:00:00Z", "uuid":"00000000-0000-0000-0000-000000000000"}, "message":"audio refreshed correctly"}`,
+		},
+		{
+			name:          "404_audio_info_not_found",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().Refresh(uuid).Return(nil, audioService.ErrAudioInfoNotFound)
+			},
+			expectedCode: 404,
+			expectedBody: `{"error":"audio info not found", "message":"audio info not found"}`,
+		},
+		{
+			name:          "200_no_rows",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().Refresh(uuid).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data": {}, "message":"no rows find"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			audioService := mockservice.NewMockIAudioService(c)
+			testCase.mockBehaviour(audioService, testCase.inputUUID)
+
+			services := service.Service{Audio: audioService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/audios/:uuid/refresh", handler.audioRefresh)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/audios/"+testCase.inputPathUUID+"/refresh", nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_audioAnalysis(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioService, uuid pgtype.UUID)
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		inputUUID     pgtype.UUID
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_valid_uuid",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().Analyze(uuid).Return(&lyrics.Analysis{
+					Language:        lyrics.LangEnglish,
+					Verses:          2,
+					Lines:           4,
+					Words:           20,
+					UniqueWords:     8,
+					UniqueRatio:     0.4,
+					RepetitionScore: 0.5,
+					TopWords:        []lyrics.WordCount{{Word: "gonna", Count: 4}, {Word: "never", Count: 4}},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{"language":"en", "verses":2, "lines":4, "words":20, "unique_words":8, "unique_ratio":0.4, "repetition_score":0.5,
+				"top_words":[{"word":"gonna", "count":4}, {"word":"never", "count":4}]}, "message":"analysis got correctly"}`,
+		},
+		{
+			name:          "200_no_rows",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().Analyze(uuid).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{}, "message":"no rows find"}`,
+		},
+		{
+			name:          "400_invalid_uuid",
+			inputPathUUID: "invalid",
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"cannot parse UUID invalid", "message":"invalid uuid in path param"}`,
+		},
+		{
+			name:          "500_unknown_error",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			inputUUID:     pgtype.UUID{Valid: true},
+			mockBehaviour: func(s *mockservice.MockIAudioService, uuid pgtype.UUID) {
+				s.EXPECT().Analyze(uuid).Return(nil, errors.New("unknown error"))
+			},
+			expectedCode: 500,
+			expectedBody: `{"error":"unknown error", "message":"error on analyze audio lyrics"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			audioService := mockservice.NewMockIAudioService(c)
+			testCase.mockBehaviour(audioService, testCase.inputUUID)
+
+			services := service.Service{Audio: audioService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/audios/:uuid/analysis", handler.audioAnalysis)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/audios/"+testCase.inputPathUUID+"/analysis", nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
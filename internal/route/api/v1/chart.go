@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/schema"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initChartHandler(r *httprouter.Router) {
+	r.GET("/api/v1/charts", h.chartList)
+}
+
+// chartList godoc
+// @Tags         Chart API
+// @Summary      List chart
+// @Description  List most played audios or groups for period, top positions first. Previous position is
+// @Description  of the preceding period of the same length, for all time it is as of a week ago.
+// @Description  Charts are refreshed periodically, refreshed_at is time of last refresh
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string false "User ID, audios are marked with is_favorite"
+// @Param period 	query string false "chart period, default week" Enums(week, month, all)
+// @Param by 		query string false "chart audios or groups, default audio" Enums(audio, group)
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseChartEntryRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /charts [get]
+func (h *Handler) chartList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	filter := schema.RequestChartFilter{}
+	filter.ScanQuery(r.URL)
+	period, kind, err := filter.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	entries, err := h.s.Chart.List(period, kind, pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list chart")
+		return
+	}
+
+	entrySchemas := make([]schema.ResponseChartEntryRead, 0, len(entries))
+	for i := 0; i < len(entries); i++ {
+		e := schema.ResponseChartEntryRead{}
+		e.FromDTO(&entries[i])
+		entrySchemas = append(entrySchemas, e)
+	}
+
+	if kind == dto.ChartByAudio {
+		err = h.markFavorites(r, len(entrySchemas), func(i int) *schema.ResponseAudioRead { return entrySchemas[i].Audio })
+		if err != nil {
+			WriteResponseErr(w, http.StatusInternalServerError, err, "error on find favorites")
+			return
+		}
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, entrySchemas, "chart got correctly")
+}
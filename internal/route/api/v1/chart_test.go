@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"eMobile/internal/config"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_chartList(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIChartService, pag crud.Pagination)
+
+	testTable := []struct {
+		name          string
+		query         string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name: "200_default_audio_week",
+			mockBehaviour: func(s *mockservice.MockIChartService, pag crud.Pagination) {
+				s.EXPECT().List(dto.ChartPeriodWeek, dto.ChartByAudio, pag).Return([]dto.ChartEntryRead{
+					{
+						Position:         1,
+						PreviousPosition: pgtype.Int8{Int64: 3, Valid: true},
+						Plays:            42,
+						Audio:            &dto.AudioRead{Group: "group1", Song: "song1"},
+					},
+					{
+						Position: 2,
+						Plays:    40,
+						Audio:    &dto.AudioRead{Group: "group2", Song: "song2"},
+					},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":[
+				{"position":1, "previous_position":3, "movement":"up", "plays":42, "refreshed_at":null, "audio":{"uuid":null, "artist_uuid":null, "group":"group1", "song":"song1", "release_date":null, "link":"", "created_at":null, "updated_at":null}},
+				{"position":2, "movement":"new", "plays":40, "refreshed_at":null, "audio":{"uuid":null, "artist_uuid":null, "group":"group2", "song":"song2", "release_date":null, "link":"", "created_at":null, "updated_at":null}}
+			], "message":"chart got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+		},
+		{
+			name:  "200_group_month",
+			query: "?period=month&by=group",
+			mockBehaviour: func(s *mockservice.MockIChartService, pag crud.Pagination) {
+				s.EXPECT().List(dto.ChartPeriodMonth, dto.ChartByGroup, pag).Return([]dto.ChartEntryRead{
+					{
+						Position:         1,
+						PreviousPosition: pgtype.Int8{Int64: 1, Valid: true},
+						Plays:            100,
+						Artist:           &dto.ArtistRead{Name: "group1"},
+					},
+					{
+						Position:         2,
+						PreviousPosition: pgtype.Int8{Int64: 1, Valid: true},
+						Plays:            90,
+						Artist:           &dto.ArtistRead{Name: "group2"},
+					},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":[
+				{"position":1, "previous_position":1, "movement":"same", "plays":100, "refreshed_at":null, "artist":{"uuid":null, "name":"group1", "country":"", "description":"", "created_at":null, "updated_at":null}},
+				{"position":2, "previous_position":1, "movement":"down", "plays":90, "refreshed_at":null, "artist":{"uuid":null, "name":"group2", "country":"", "description":"", "created_at":null, "updated_at":null}}
+			], "message":"chart got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+		},
+		{
+			name:          "400_invalid_query",
+			query:         "?period=year&by=genre",
+			mockBehaviour: func(s *mockservice.MockIChartService, pag crud.Pagination) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'period' must be one of: week, month, all;'by' must be one of: audio, group;", "message":"validation err"}`,
+		},
+		{
+			name:  "500_service_error",
+			query: "?period=all",
+			mockBehaviour: func(s *mockservice.MockIChartService, pag crud.Pagination) {
+				s.EXPECT().List(dto.ChartPeriodAll, dto.ChartByAudio, pag).Return(nil, errors.New("some error"))
+			},
+			expectedCode: 500,
+			expectedBody: `{"error":"some error", "message":"error on list chart"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			chartService := mockservice.NewMockIChartService(c)
+			testCase.mockBehaviour(chartService, crud.Pagination{Offset: 0, Limit: 50})
+
+			services := service.Service{Chart: chartService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+				Config: &config.Config{
+					Server: config.Server{
+						PagLimit: 50,
+					},
+				},
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/charts", handler.chartList)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/charts"+testCase.query, nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/dto"
+	"eMobile/internal/schema"
+	"eMobile/pkg/thumbnail"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"io"
+	"net/http"
+)
+
+func (h *Handler) initCoverHandler(r *httprouter.Router) {
+	r.PUT("/api/v1/audios/:uuid/cover", h.audioCoverSet)
+	r.DELETE("/api/v1/audios/:uuid/cover", h.audioCoverDelete)
+	r.PUT("/api/v1/albums/:uuid/cover", h.albumCoverSet)
+	r.DELETE("/api/v1/albums/:uuid/cover", h.albumCoverDelete)
+}
+
+// audioCoverSet godoc
+// @Tags         Cover API
+// @Summary      Upload audio cover
+// @Description  Upload jpeg or png image as raw body. Previous cover is replaced.
+// @Description  Thumbnails of 64, 256 and 640 px are generated
+// @Accept       image/jpeg,image/png
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param Image body string true "Image"
+// @Success      200  {object}  ResponseBase[schema.ResponseCover]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      413  {object}  ResponseBaseErr
+// @Failure      415  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/cover [put]
+func (h *Handler) audioCoverSet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.coverSet(w, r, ps, dto.CoverTargetAudio)
+}
+
+// audioCoverDelete godoc
+// @Tags         Cover API
+// @Summary      Delete audio cover
+// @Description  Delete audio cover with its thumbnails
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/cover [delete]
+func (h *Handler) audioCoverDelete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.coverDelete(w, ps, dto.CoverTargetAudio)
+}
+
+// albumCoverSet godoc
+// @Tags         Cover API
+// @Summary      Upload album cover
+// @Description  Upload jpeg or png image as raw body. Previous cover is replaced.
+// @Description  Thumbnails of 64, 256 and 640 px are generated
+// @Accept       image/jpeg,image/png
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Param Image body string true "Image"
+// @Success      200  {object}  ResponseBase[schema.ResponseCover]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      413  {object}  ResponseBaseErr
+// @Failure      415  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid}/cover [put]
+func (h *Handler) albumCoverSet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.coverSet(w, r, ps, dto.CoverTargetAlbum)
+}
+
+// albumCoverDelete godoc
+// @Tags         Cover API
+// @Summary      Delete album cover
+// @Description  Delete album cover with its thumbnails
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Album UUID"
+// @Success      200  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /albums/{uuid}/cover [delete]
+func (h *Handler) albumCoverDelete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.coverDelete(w, ps, dto.CoverTargetAlbum)
+}
+
+// coverSet
+// read image from body and store it as cover of target
+func (h *Handler) coverSet(w http.ResponseWriter, r *http.Request, ps httprouter.Params, target string) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		maxBytesErr := &http.MaxBytesError{}
+		if errors.As(err, &maxBytesErr) {
+			WriteResponseErr(w, http.StatusRequestEntityTooLarge, err, "image is too large")
+			return
+		}
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	key, err := h.s.Cover.Set(target, uuid, data)
+	if err != nil {
+		if errors.Is(err, thumbnail.ErrUnsupportedFormat) {
+			WriteResponseErr(w, http.StatusUnsupportedMediaType, err, "validation err")
+			return
+		}
+		if errors.Is(err, thumbnail.ErrTooLarge) {
+			WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on set cover")
+		return
+	}
+
+	cover := schema.NewResponseCover(pgtype.Text{String: key, Valid: true})
+	WriteResponse(w, http.StatusOK, cover, "cover set correctly")
+}
+
+// coverDelete
+// remove cover of target
+func (h *Handler) coverDelete(w http.ResponseWriter, ps httprouter.Params, target string) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Cover.Delete(target, uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete cover")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, uuid, "cover deleted correctly")
+}
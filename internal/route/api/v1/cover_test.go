@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"eMobile/pkg/thumbnail"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_audioCoverSet(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockICoverService, uuid pgtype.UUID, data []byte)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		bodyLimit     int64
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "200_cover_set",
+			inputBody: "png image",
+			mockBehaviour: func(s *mockservice.MockICoverService, uuid pgtype.UUID, data []byte) {
+				s.EXPECT().Set(dto.CoverTargetAudio, uuid, data).Return("covers/audios/00/v1/original.png", nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{
+				"original":"/blob/covers/audios/00/v1/original.png",
+				"thumbnails":{
+					"64":"/blob/covers/audios/00/v1/64.jpg",
+					"256":"/blob/covers/audios/00/v1/256.jpg",
+					"640":"/blob/covers/audios/00/v1/640.jpg"
+				}
+			}, "message":"cover set correctly"}`,
+		},
+		{
+			name:      "415_unsupported_format",
+			inputBody: "GIF89a",
+			mockBehaviour: func(s *mockservice.MockICoverService, uuid pgtype.UUID, data []byte) {
+				s.EXPECT().Set(dto.CoverTargetAudio, uuid, data).Return("", thumbnail.ErrUnsupportedFormat)
+			},
+			expectedCode: 415,
+			expectedBody: `{"error":"image must be jpeg or png", "message":"validation err"}`,
+		},
+		{
+			name:          "413_too_large",
+			inputBody:     "large png image",
+			bodyLimit:     4,
+			mockBehaviour: func(s *mockservice.MockICoverService, uuid pgtype.UUID, data []byte) {},
+			expectedCode:  413,
+			expectedBody:  `{"error":"http: request body too large", "message":"image is too large"}`,
+		},
+		{
+			name:      "200_no_rows",
+			inputBody: "png image",
+			mockBehaviour: func(s *mockservice.MockICoverService, uuid pgtype.UUID, data []byte) {
+				s.EXPECT().Set(dto.CoverTargetAudio, uuid, data).Return("", pgx.ErrNoRows)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{}, "message":"no rows updated"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			coverService := mockservice.NewMockICoverService(c)
+			testCase.mockBehaviour(coverService, pgtype.UUID{Valid: true}, []byte(testCase.inputBody))
+
+			services := service.Service{Cover: coverService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.PUT("/audios/:uuid/cover", handler.audioCoverSet)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PUT", "/audios/00000000-0000-0000-0000-000000000000/cover", strings.NewReader(testCase.inputBody))
+			if testCase.bodyLimit > 0 {
+				req.Body = http.MaxBytesReader(w, req.Body, testCase.bodyLimit)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_albumCoverDelete(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockICoverService, uuid pgtype.UUID)
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_cover_deleted",
+			inputPathUUID: "00000000-0000-0000-0000-000000000000",
+			mockBehaviour: func(s *mockservice.MockICoverService, uuid pgtype.UUID) {
+				s.EXPECT().Delete(dto.CoverTargetAlbum, uuid).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"cover deleted correctly"}`,
+		},
+		{
+			name:          "400_invalid_uuid",
+			inputPathUUID: "cover",
+			mockBehaviour: func(s *mockservice.MockICoverService, uuid pgtype.UUID) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"cannot parse UUID cover", "message":"invalid uuid in path param"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			coverService := mockservice.NewMockICoverService(c)
+			testCase.mockBehaviour(coverService, pgtype.UUID{Valid: true})
+
+			services := service.Service{Cover: coverService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.DELETE("/albums/:uuid/cover", handler.albumCoverDelete)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("DELETE", "/albums/"+testCase.inputPathUUID+"/cover", nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initCreditHandler(r *httprouter.Router) {
+	r.GET("/api/v1/audios/:uuid/credits", h.creditList)
+	r.POST("/api/v1/audios/:uuid/credits", h.creditCreate)
+	r.DELETE("/api/v1/audios/:uuid/credits/:credit_uuid", h.creditDelete)
+}
+
+// creditList godoc
+// @Tags         Credit API
+// @Summary      List audio credits by UUID
+// @Description  List performers, writers, composers and producers credited on audio
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[[]schema.ResponseCreditRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/credits [get]
+func (h *Handler) creditList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	credits, err := h.s.Credit.ListByAudio(uuid)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list audio credits")
+		return
+	}
+
+	creditSchemas := make([]schema.ResponseCreditRead, 0, len(credits))
+	for i := 0; i < len(credits); i++ {
+		c := schema.ResponseCreditRead{}
+		c.FromDTO(&credits[i])
+		creditSchemas = append(creditSchemas, c)
+	}
+	WriteResponse(w, http.StatusOK, creditSchemas, "credits got correctly")
+}
+
+// creditCreate godoc
+// @Tags         Credit API
+// @Summary      Credit person on audio
+// @Description  Credit person on audio in role. Person is matched by canonical name and created if not exists
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param Credit body schema.RequestCreditCreate false "Credit base"
+// @Success      201  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/credits [post]
+func (h *Handler) creditCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	credit := schema.RequestCreditCreate{}
+	err = json.NewDecoder(r.Body).Decode(&credit)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	creditDTO, err := credit.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	creditUUID, err := h.s.Credit.Create(uuid, creditDTO)
+	if err != nil {
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "credit already exists")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "create credit err")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: creditUUID}, "credit created correctly")
+}
+
+// creditDelete godoc
+// @Tags         Credit API
+// @Summary      Delete audio credit by UUID
+// @Description  Delete audio credit by UUID, person is kept
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param credit_uuid path string false "Credit UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/credits/{credit_uuid} [delete]
+func (h *Handler) creditDelete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	creditUUID, err := h.getNamedUUIDParam(ps, "credit_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Credit.Delete(uuid, creditUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete audio credit")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: creditUUID}, "credit deleted correctly")
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_creditCreate(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockICreditService, uuid pgtype.UUID, credit *dto.CreditCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.CreditCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_valid_input",
+			inputBody: `{"name": " Paul McCartney ", "role": "writer"}`,
+			inputDTO:  &dto.CreditCreate{Name: "Paul McCartney", Role: dto.RoleWriter},
+			mockBehaviour: func(s *mockservice.MockICreditService, uuid pgtype.UUID, credit *dto.CreditCreate) {
+				s.EXPECT().Create(uuid, credit).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"credit created correctly"}`,
+		},
+		{
+			name:          "400_invalid_role",
+			inputBody:     `{"name": "Paul McCartney", "role": "singer"}`,
+			mockBehaviour: func(s *mockservice.MockICreditService, uuid pgtype.UUID, credit *dto.CreditCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'role' must be one of: performer, featured, writer, composer, lyricist, producer;", "message":"validation err"}`,
+		},
+		{
+			name:      "409_already_credited",
+			inputBody: `{"name": "Paul McCartney", "role": "writer"}`,
+			inputDTO:  &dto.CreditCreate{Name: "Paul McCartney", Role: dto.RoleWriter},
+			mockBehaviour: func(s *mockservice.MockICreditService, uuid pgtype.UUID, credit *dto.CreditCreate) {
+				s.EXPECT().Create(uuid, credit).Return(pgtype.UUID{}, fmt.Errorf("%w: role", crud.ErrUniqueViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"already exists: role", "message":"credit already exists"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			creditService := mockservice.NewMockICreditService(c)
+			testCase.mockBehaviour(creditService, pgtype.UUID{Valid: true}, testCase.inputDTO)
+
+			services := service.Service{Credit: creditService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/audios/:uuid/credits", handler.creditCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/audios/00000000-0000-0000-0000-000000000000/credits", strings.NewReader(testCase.inputBody))
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/pkg/canon"
+	"eMobile/pkg/playlistfile"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportContentTypes
+// media types of Accept header answered with playlist file
+// instead of JSON, mapped to content type of response
+var exportContentTypes = map[string]string{
+	"audio/x-mpegurl":               playlistfile.ContentTypeM3U,
+	"audio/mpegurl":                 playlistfile.ContentTypeM3U,
+	"application/x-mpegurl":         playlistfile.ContentTypeM3U,
+	"application/vnd.apple.mpegurl": playlistfile.ContentTypeM3U,
+	"application/xspf+xml":          playlistfile.ContentTypeXSPF,
+}
+
+// jsonMediaTypes
+// media types of Accept header answered with JSON
+var jsonMediaTypes = map[string]bool{
+	"application/json": true,
+	"application/*":    true,
+	"*/*":              true,
+}
+
+// maxExportTracks
+// exported audio list is cut after this many tracks
+const maxExportTracks = 10000
+
+// exportBatch
+// audios read by one query of export
+const exportBatch = 1000
+
+// exportContentType
+// return playlist file content type requested by Accept header, media
+// type with highest q-value wins, first one on tie. Empty if JSON wins
+func exportContentType(r *http.Request) string {
+	best, bestQ := "", 0.0
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q <= bestQ {
+			continue
+		}
+		if contentType, ok := exportContentTypes[mediaType]; ok {
+			best, bestQ = contentType, q
+		} else if jsonMediaTypes[mediaType] {
+			best, bestQ = "", q
+		}
+	}
+	return best
+}
+
+// audioTrack
+// return playlist file track of audio, location is stored link
+func audioTrack(audio *dto.AudioRead) playlistfile.Track {
+	track := playlistfile.Track{
+		Title:    audio.Song,
+		Creator:  audio.Group,
+		Location: audio.Link,
+	}
+	if audio.DurationMs.Valid {
+		track.Duration = time.Duration(audio.DurationMs.Int32) * time.Millisecond
+	}
+	return track
+}
+
+// exportAudios
+// return all audios matched by filter, read in batches of exportBatch.
+// Return true if list was cut at maxExportTracks
+func (h *Handler) exportAudios(filter *dto.AudioFilter) ([]dto.AudioRead, bool, error) {
+	pag := crud.Pagination{Offset: 0, Limit: exportBatch}
+	audios := make([]dto.AudioRead, 0, exportBatch)
+	for {
+		var page []dto.AudioRead
+		var err error
+		if filter == nil {
+			page, err = h.s.Audio.ListPag(pag)
+		} else {
+			page, err = h.s.Audio.ListByFilter(filter, pag)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return audios, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		audios = append(audios, page...)
+		if len(audios) >= maxExportTracks {
+			return audios[:maxExportTracks], true, nil
+		}
+		if len(page) < pag.Limit {
+			return audios, false, nil
+		}
+		pag.Offset += pag.Limit
+	}
+}
+
+// writePlaylistFile
+// write tracks as playlist file attachment named after title
+func (h *Handler) writePlaylistFile(w http.ResponseWriter, contentType, title string, tracks []playlistfile.Track) {
+	write, ext := playlistfile.WriteM3U, ".m3u8"
+	if contentType == playlistfile.ContentTypeXSPF {
+		write, ext = playlistfile.WriteXSPF, ".xspf"
+	}
+
+	name := canon.Slug(title)
+	if name == "" {
+		name = "playlist"
+	}
+
+	w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name + ext}))
+	w.WriteHeader(http.StatusOK)
+	if err := write(w, &playlistfile.Playlist{Title: title, Tracks: tracks}); err != nil {
+		h.l.Error("Error on writing playlist file: ", err)
+	}
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"eMobile/internal/config"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"eMobile/pkg/playlistfile"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_audioListExport(t *testing.T) {
+	testTable := []struct {
+		name                string
+		accept              string
+		expectedType        string
+		expectedDisposition string
+		expectedBody        string
+	}{
+		{
+			name:                "200_m3u",
+			accept:              "audio/x-mpegurl",
+			expectedType:        "audio/x-mpegurl; charset=utf-8",
+			expectedDisposition: `attachment; filename=audios.m3u8`,
+			expectedBody:        "#EXTM3U\n#PLAYLIST:audios\n#EXTINF:213,Muse - Supermassive Black Hole\nhttps://youtu.be/Xsp3_a-PMTw\n",
+		},
+		{
+			name:                "200_apple_mpegurl_with_params",
+			accept:              "text/html, application/vnd.apple.mpegurl;q=0.9",
+			expectedType:        "audio/x-mpegurl; charset=utf-8",
+			expectedDisposition: `attachment; filename=audios.m3u8`,
+			expectedBody:        "#EXTM3U\n#PLAYLIST:audios\n#EXTINF:213,Muse - Supermassive Black Hole\nhttps://youtu.be/Xsp3_a-PMTw\n",
+		},
+		{
+			name:                "200_xspf",
+			accept:              "application/xspf+xml",
+			expectedType:        "application/xspf+xml; charset=utf-8",
+			expectedDisposition: `attachment; filename=audios.xspf`,
+			expectedBody: `<?xml version="1.0" encoding="UTF-8"?>
+<playlist xmlns="http://xspf.org/ns/0/" version="1">
+  <title>audios</title>
+  <trackList>
+    <track>
+      <location>https://youtu.be/Xsp3_a-PMTw</location>
+      <creator>Muse</creator>
+      <title>Supermassive Black Hole</title>
+      <duration>213000</duration>
+    </track>
+  </trackList>
+</playlist>
+`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			audioService := mockservice.NewMockIAudioService(c)
+			audioService.EXPECT().ListPag(crud.Pagination{Offset: 0, Limit: exportBatch}).Return([]dto.AudioRead{{
+				UUID:       pgtype.UUID{Valid: true},
+				Group:      "Muse",
+				Song:       "Supermassive Black Hole",
+				Link:       "https://youtu.be/Xsp3_a-PMTw",
+				DurationMs: pgtype.Int4{Int32: 213000, Valid: true},
+			}}, nil)
+
+			services := service.Service{Audio: audioService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+				Config: &config.Config{
+					Server: config.Server{
+						PagLimit: 50,
+					},
+				},
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/audios", handler.audioList)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/audios", nil)
+			req.Header.Set("Accept", testCase.accept)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, 200, w.Code)
+			assert.Equal(t, testCase.expectedType, w.Header().Get("Content-Type"))
+			assert.Equal(t, testCase.expectedDisposition, w.Header().Get("Content-Disposition"))
+			assert.Equal(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_audioListExportPages(t *testing.T) {
+	//Init Deps
+	c := gomock.NewController(t)
+	defer c.Finish()
+	audioService := mockservice.NewMockIAudioService(c)
+	batch := make([]dto.AudioRead, exportBatch)
+	for i := range batch {
+		batch[i] = dto.AudioRead{Group: "Muse", Song: "Uprising", Link: "https://youtu.be/w8KQmps-Sog"}
+	}
+	gomock.InOrder(
+		audioService.EXPECT().ListPag(crud.Pagination{Offset: 0, Limit: exportBatch}).Return(batch, nil),
+		audioService.EXPECT().ListPag(crud.Pagination{Offset: exportBatch, Limit: exportBatch}).Return([]dto.AudioRead{{
+			Group: "Muse",
+			Song:  "Starlight",
+			Link:  "https://youtu.be/Pgum6OT_VH8",
+		}}, nil),
+	)
+
+	services := service.Service{Audio: audioService}
+	handler := NewHandler(Deps{
+		Service: services,
+		Logger:  logging.GetLoggerTest(),
+		Config: &config.Config{
+			Server: config.Server{
+				PagLimit: 50,
+			},
+		},
+	})
+
+	//Test server
+	r := httprouter.New()
+	r.GET("/audios", handler.audioList)
+
+	//http test
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/audios?limit=1&offset=5", nil)
+	req.Header.Set("Accept", "audio/x-mpegurl")
+
+	//Perform request
+	r.ServeHTTP(w, req)
+
+	//Assert
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "", w.Header().Get("X-Export-Truncated"))
+	assert.Equal(t, exportBatch+1, strings.Count(w.Body.String(), "#EXTINF:"))
+	assert.True(t, strings.HasSuffix(w.Body.String(), "#EXTINF:-1,Muse - Starlight\nhttps://youtu.be/Pgum6OT_VH8\n"))
+}
+
+func TestExportContentType(t *testing.T) {
+	testTable := []struct {
+		name     string
+		accept   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			accept:   "",
+			expected: "",
+		},
+		{
+			name:     "json_preferred",
+			accept:   "application/json, audio/x-mpegurl;q=0.1",
+			expected: "",
+		},
+		{
+			name:     "m3u_preferred",
+			accept:   "application/json;q=0.5, audio/x-mpegurl",
+			expected: playlistfile.ContentTypeM3U,
+		},
+		{
+			name:     "tie_first_wins",
+			accept:   "application/xspf+xml, */*",
+			expected: playlistfile.ContentTypeXSPF,
+		},
+		{
+			name:     "not_acceptable",
+			accept:   "audio/x-mpegurl;q=0",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/audios", nil)
+			req.Header.Set("Accept", testCase.accept)
+
+			assert.Equal(t, testCase.expected, exportContentType(req))
+		})
+	}
+}
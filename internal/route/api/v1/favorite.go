@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initFavoriteHandler(r *httprouter.Router) {
+	r.PUT("/api/v1/audios/:uuid/favorite", h.favoriteAdd)
+	r.DELETE("/api/v1/audios/:uuid/favorite", h.favoriteRemove)
+	r.GET("/api/v1/me/favorites", h.favoriteList)
+}
+
+// favoriteAdd godoc
+// @Tags         Favorite API
+// @Summary      Like audio
+// @Description  Add audio to favorites of user from X-User-ID header. Liking favorite audio again does nothing
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/favorite [put]
+func (h *Handler) favoriteAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	userID := h.getUserID(r)
+	if userID == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	err = h.s.Favorite.Add(userID, uuid)
+	if err != nil {
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on add favorite")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "audio added to favorites")
+}
+
+// favoriteRemove godoc
+// @Tags         Favorite API
+// @Summary      Unlike audio
+// @Description  Remove audio from favorites of user from X-User-ID header
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/favorite [delete]
+func (h *Handler) favoriteRemove(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	userID := h.getUserID(r)
+	if userID == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	err = h.s.Favorite.Remove(userID, uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on remove favorite")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "audio removed from favorites")
+}
+
+// favoriteList godoc
+// @Tags         Favorite API
+// @Summary      List favorites
+// @Description  List favorite audios of user from X-User-ID header, recently liked first
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseFavoriteRead]
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /me/favorites [get]
+func (h *Handler) favoriteList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := h.getUserID(r)
+	if userID == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	favorites, err := h.s.Favorite.ListPag(userID, pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list favorites")
+		return
+	}
+
+	favoriteSchemas := make([]schema.ResponseFavoriteRead, 0, len(favorites))
+	for i := 0; i < len(favorites); i++ {
+		f := schema.ResponseFavoriteRead{}
+		f.FromDTO(&favorites[i])
+		favoriteSchemas = append(favoriteSchemas, f)
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, favoriteSchemas, "favorites got correctly")
+}
+
+// markFavorites
+// set is_favorite of n audios returned by audio for user making request,
+// audios of anonymous requests are left unmarked
+func (h *Handler) markFavorites(r *http.Request, n int, audio func(i int) *schema.ResponseAudioRead) error {
+	userID := h.getUserID(r)
+	if userID == "" || n == 0 {
+		return nil
+	}
+
+	uuids := make([]pgtype.UUID, 0, n)
+	for i := 0; i < n; i++ {
+		uuids = append(uuids, audio(i).UUID)
+	}
+
+	favorites, err := h.s.Favorite.Filter(userID, uuids)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		isFavorite := favorites[audio(i).UUID]
+		audio(i).IsFavorite = &isFavorite
+	}
+	return nil
+}
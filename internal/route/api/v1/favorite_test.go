@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"eMobile/internal/config"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_favoriteAdd(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIFavoriteService, uuid pgtype.UUID)
+
+	testTable := []struct {
+		name          string
+		userID        string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:   "200_added",
+			userID: "user1",
+			mockBehaviour: func(s *mockservice.MockIFavoriteService, uuid pgtype.UUID) {
+				s.EXPECT().Add("user1", uuid).Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"audio added to favorites"}`,
+		},
+		{
+			name:          "401_no_user",
+			mockBehaviour: func(s *mockservice.MockIFavoriteService, uuid pgtype.UUID) {},
+			expectedCode:  401,
+			expectedBody:  `{"error":"X-User-ID header is required", "message":"user is not identified"}`,
+		},
+		{
+			name:   "409_unknown_audio",
+			userID: "user1",
+			mockBehaviour: func(s *mockservice.MockIFavoriteService, uuid pgtype.UUID) {
+				s.EXPECT().Add("user1", uuid).Return(fmt.Errorf("%w: audio_uuid", crud.ErrForeignKeyViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"referenced by other rows: audio_uuid", "message":"audio does not exist"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			favoriteService := mockservice.NewMockIFavoriteService(c)
+			testCase.mockBehaviour(favoriteService, pgtype.UUID{Valid: true})
+
+			services := service.Service{Favorite: favoriteService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.PUT("/audios/:uuid/favorite", handler.favoriteAdd)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PUT", "/audios/00000000-0000-0000-0000-000000000000/favorite", nil)
+			if testCase.userID != "" {
+				req.Header.Set("X-User-ID", testCase.userID)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_favoriteList(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIFavoriteService, pag crud.Pagination)
+
+	testTable := []struct {
+		name          string
+		userID        string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:   "200_favorites",
+			userID: "user1",
+			mockBehaviour: func(s *mockservice.MockIFavoriteService, pag crud.Pagination) {
+				s.EXPECT().ListPag("user1", pag).Return([]dto.FavoriteRead{{
+					AudioRead: dto.AudioRead{
+						UUID:       pgtype.UUID{Valid: true},
+						ArtistUUID: pgtype.UUID{Valid: true},
+						Group:      "group1",
+						Song:       "song1",
+					},
+				}}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":[{"uuid":"00000000-0000-0000-0000-000000000000", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "song":"song1", "release_date":null, "link":"", "is_favorite":true, "created_at":null, "updated_at":null, "favorited_at":null}], "message":"favorites got correctly", "next_pagination":{"limit":50, "offset":50}}`,
+		},
+		{
+			name:          "401_no_user",
+			mockBehaviour: func(s *mockservice.MockIFavoriteService, pag crud.Pagination) {},
+			expectedCode:  401,
+			expectedBody:  `{"error":"X-User-ID header is required", "message":"user is not identified"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			favoriteService := mockservice.NewMockIFavoriteService(c)
+			testCase.mockBehaviour(favoriteService, crud.Pagination{Offset: 0, Limit: 50})
+
+			services := service.Service{Favorite: favoriteService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+				Config: &config.Config{
+					Server: config.Server{
+						PagLimit: 50,
+					},
+				},
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/me/favorites", handler.favoriteList)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/me/favorites", nil)
+			if testCase.userID != "" {
+				req.Header.Set("X-User-ID", testCase.userID)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_audioListFavorites(t *testing.T) {
+	audios := []dto.AudioRead{
+		{UUID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Group: "group1", Song: "song1"},
+		{UUID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Group: "group2", Song: "song2"},
+	}
+
+	//Init Deps
+	c := gomock.NewController(t)
+	defer c.Finish()
+	audioService := mockservice.NewMockIAudioService(c)
+	audioService.EXPECT().ListPag(crud.Pagination{Offset: 0, Limit: 50}).Return(audios, nil)
+	favoriteService := mockservice.NewMockIFavoriteService(c)
+	favoriteService.EXPECT().Filter("user1", []pgtype.UUID{audios[0].UUID, audios[1].UUID}).
+		Return(map[pgtype.UUID]bool{audios[1].UUID: true}, nil)
+
+	services := service.Service{Audio: audioService, Favorite: favoriteService}
+	handler := NewHandler(Deps{
+		Service: services,
+		Logger:  logging.GetLoggerTest(),
+		Config: &config.Config{
+			Server: config.Server{
+				PagLimit: 50,
+			},
+		},
+	})
+
+	//Test server
+	r := httprouter.New()
+	r.GET("/audios", handler.audioList)
+
+	//http test
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/audios", nil)
+	req.Header.Set("X-User-ID", "user1")
+
+	//Perform request
+	r.ServeHTTP(w, req)
+
+	//Assert
+	assert.Equal(t, 200, w.Code)
+	assert.JSONEq(t, `{"data":[
+		{"uuid":"01000000-0000-0000-0000-000000000000", "artist_uuid":null, "group":"group1", "song":"song1", "release_date":null, "link":"", "is_favorite":false, "created_at":null, "updated_at":null},
+		{"uuid":"02000000-0000-0000-0000-000000000000", "artist_uuid":null, "group":"group2", "song":"song2", "release_date":null, "link":"", "is_favorite":true, "created_at":null, "updated_at":null}
+	], "message":"audios got correctly", "next_pagination":{"limit":50, "offset":50}}`, w.Body.String())
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"eMobile/internal/service/audioFileService"
+	"eMobile/pkg/audiotag"
+	"eMobile/pkg/blob"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+	"strings"
+)
+
+func (h *Handler) initFileHandler(r *httprouter.Router) {
+	r.PUT("/api/v1/audios/:uuid/file", h.audioFileUpload)
+	r.GET("/api/v1/audios/:uuid/stream", h.audioStream)
+}
+
+// audioContentTypes
+// content type of stored audio file by format
+var audioContentTypes = map[string]string{
+	audiotag.FormatMP3:  "audio/mpeg",
+	audiotag.FormatFLAC: "audio/flac",
+	audiotag.FormatOGG:  "audio/ogg",
+}
+
+// audioFileUpload godoc
+// @Tags         File API
+// @Summary      Upload audio file
+// @Description  Upload mp3, flac or ogg file of audio as raw body, previous file is replaced.
+// @Description  Tags of file are returned with audio fields they suggest: fields missing
+// @Description  or different in audio. Suggested fields are not applied
+// @Accept       audio/mpeg,audio/flac,audio/ogg
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param File body string true "Audio file"
+// @Success      200  {object}  ResponseBase[schema.ResponseAudioFileUpload]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      413  {object}  ResponseBaseErr
+// @Failure      415  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/file [put]
+func (h *Handler) audioFileUpload(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	upload, err := h.s.File.Upload(uuid, r.Body)
+	if err != nil {
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		h.writeFileErr(w, err, "error on upload audio file")
+		return
+	}
+
+	uploadSchema := schema.ResponseAudioFileUpload{}
+	uploadSchema.FromDTO(upload)
+	WriteResponse(w, http.StatusOK, uploadSchema, "audio file uploaded correctly")
+}
+
+// audioStream godoc
+// @Tags         File API
+// @Summary      Stream audio file
+// @Description  Return uploaded audio file. Range requests are answered with 206 Partial Content,
+// @Description  ETag is sha256 of file, If-None-Match, If-Modified-Since and If-Range are supported
+// @Produce      audio/mpeg,audio/flac,audio/ogg
+// @Param uuid path string false "Audio UUID"
+// @Param Range header string false "Byte range, example: bytes=0-1023"
+// @Success      200  {file}    file
+// @Success      206  {file}    file
+// @Success      304  "Not modified"
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      404  {object}  ResponseBaseErr
+// @Failure      416  "Range not satisfiable"
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/stream [get]
+func (h *Handler) audioStream(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	file, content, err := h.s.File.Open(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, blob.ErrNotFound) {
+			WriteResponseErr(w, http.StatusNotFound, err, "audio file not found")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on open audio file")
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", audioContentTypes[file.Format])
+	w.Header().Set("ETag", `"`+file.SHA256+`"`)
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, "", file.CreatedAt.Time, content)
+}
+
+// audioCreateFromFile
+// create audio from tags of uploaded file, called by audioCreate
+// for requests with audio/* content type
+func (h *Handler) audioCreateFromFile(w http.ResponseWriter, r *http.Request) {
+	_, upload, err := h.s.File.CreateFromUpload(r.Body, h.getUserID(r))
+	if err != nil {
+		h.writeFileErr(w, err, "create audio err")
+		return
+	}
+
+	uploadSchema := schema.ResponseAudioFileUpload{}
+	uploadSchema.FromDTO(upload)
+	WriteResponse(w, http.StatusCreated, uploadSchema, "audio created correctly")
+}
+
+// isAudioUpload
+// return true if request body is audio file
+func isAudioUpload(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "audio/")
+}
+
+// writeFileErr
+// write response for errors of reading and storing uploaded file
+func (h *Handler) writeFileErr(w http.ResponseWriter, err error, message string) {
+	maxBytesErr := &http.MaxBytesError{}
+	switch {
+	case errors.As(err, &maxBytesErr):
+		WriteResponseErr(w, http.StatusRequestEntityTooLarge, err, "file is too large")
+	case errors.Is(err, audiotag.ErrUnsupportedFormat):
+		WriteResponseErr(w, http.StatusUnsupportedMediaType, err, "validation err")
+	case errors.Is(err, audiotag.ErrMalformed), errors.Is(err, audioFileService.ErrMissingTags):
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+	default:
+		WriteResponseErr(w, http.StatusInternalServerError, err, message)
+	}
+}
@@ -0,0 +1,353 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	"eMobile/internal/service/audioFileService"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/audiotag"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_audioFileUpload(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID)
+
+	upload := &dto.AudioFileUpload{
+		File: dto.AudioFileRead{
+			AudioUUID:  pgtype.UUID{Valid: true},
+			Format:     audiotag.FormatMP3,
+			Size:       4096,
+			SHA256:     "abc",
+			DurationMs: pgtype.Int4{Int32: 213000, Valid: true},
+		},
+		Tags: audiotag.Tags{
+			Format:      audiotag.FormatMP3,
+			Title:       "Song",
+			Artist:      "Band",
+			Year:        1987,
+			TrackNumber: 2,
+			Duration:    213 * time.Second,
+		},
+		Suggested: dto.AudioSuggestion{
+			ReleaseDate:          pgtype.Date{Time: time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			ReleaseDatePrecision: dto.PrecisionYear,
+			DurationMs:           pgtype.Int4{Int32: 213000, Valid: true},
+			TrackNumber:          pgtype.Int4{Int32: 2, Valid: true},
+		},
+	}
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		bodyLimit     int64
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "200_uploaded",
+			inputBody: "mp3 file",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID) {
+				s.EXPECT().Upload(uuid, gomock.Any()).Return(upload, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{
+				"file":{"audio_uuid":"00000000-0000-0000-0000-000000000000", "format":"mp3", "size":4096, "sha256":"abc",
+					"duration_ms":213000, "created_at":null},
+				"tags":{"title":"Song", "artist":"Band", "year":1987, "track_number":2, "duration_ms":213000},
+				"suggested":{"release_date":"1987", "duration_ms":213000, "track_number":2}
+			}, "message":"audio file uploaded correctly"}`,
+		},
+		{
+			name:      "415_unsupported_format",
+			inputBody: "RIFF....WAVE",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID) {
+				s.EXPECT().Upload(uuid, gomock.Any()).Return(nil, audiotag.ErrUnsupportedFormat)
+			},
+			expectedCode: 415,
+			expectedBody: `{"error":"audio must be mp3, flac or ogg", "message":"validation err"}`,
+		},
+		{
+			name:      "413_too_large",
+			inputBody: "large mp3 file",
+			bodyLimit: 4,
+			mockBehaviour: func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID) {
+				s.EXPECT().Upload(uuid, gomock.Any()).DoAndReturn(func(_ pgtype.UUID, body io.Reader) (*dto.AudioFileUpload, error) {
+					_, err := body.Read(make([]byte, 64))
+					return nil, err
+				})
+			},
+			expectedCode: 413,
+			expectedBody: `{"error":"http: request body too large", "message":"file is too large"}`,
+		},
+		{
+			name:      "409_audio_not_exists",
+			inputBody: "mp3 file",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID) {
+				s.EXPECT().Upload(uuid, gomock.Any()).Return(nil, fmt.Errorf("%w: audio_uuid", crud.ErrForeignKeyViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"referenced by other rows: audio_uuid", "message":"audio does not exist"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			fileService := mockservice.NewMockIAudioFileService(c)
+			testCase.mockBehaviour(fileService, pgtype.UUID{Valid: true})
+
+			services := service.Service{File: fileService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.PUT("/audios/:uuid/file", handler.audioFileUpload)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PUT", "/audios/00000000-0000-0000-0000-000000000000/file", strings.NewReader(testCase.inputBody))
+			if testCase.bodyLimit > 0 {
+				req.Body = http.MaxBytesReader(w, req.Body, testCase.bodyLimit)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_audioCreateFromFile(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioFileService)
+
+	testTable := []struct {
+		name          string
+		contentType   string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:        "201_created",
+			contentType: "audio/flac",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService) {
+				s.EXPECT().CreateFromUpload(gomock.Any(), "moderator").Return(pgtype.UUID{Valid: true}, &dto.AudioFileUpload{
+					File: dto.AudioFileRead{AudioUUID: pgtype.UUID{Valid: true}, Format: audiotag.FormatFLAC, Size: 10},
+					Tags: audiotag.Tags{Format: audiotag.FormatFLAC, Title: "Song", Artist: "Band", Album: "Album"},
+					Suggested: dto.AudioSuggestion{
+						Album: pgtype.Text{String: "Album", Valid: true},
+					},
+				}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":{
+				"file":{"audio_uuid":"00000000-0000-0000-0000-000000000000", "format":"flac", "size":10, "sha256":"", "created_at":null},
+				"tags":{"title":"Song", "artist":"Band", "album":"Album"},
+				"suggested":{"album":"Album"}
+			}, "message":"audio created correctly"}`,
+		},
+		{
+			name:        "400_missing_tags",
+			contentType: "audio/mpeg",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService) {
+				s.EXPECT().CreateFromUpload(gomock.Any(), "moderator").Return(pgtype.UUID{}, nil, audioFileService.ErrMissingTags)
+			},
+			expectedCode: 400,
+			expectedBody: `{"error":"file has no artist and title tags", "message":"validation err"}`,
+		},
+		{
+			name:          "400_json_body",
+			contentType:   "application/json",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"invalid character 'L' in literal false (expecting 'a')", "message":"read body err"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			fileService := mockservice.NewMockIAudioFileService(c)
+			testCase.mockBehaviour(fileService)
+
+			services := service.Service{File: fileService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/audios", handler.audioCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/audios", strings.NewReader("fLaC file"))
+			req.Header.Set("Content-Type", testCase.contentType)
+			req.Header.Set("X-User-ID", "moderator")
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+// readSeekNopCloser
+// in-memory audio file content
+type readSeekNopCloser struct {
+	*strings.Reader
+}
+
+func (readSeekNopCloser) Close() error {
+	return nil
+}
+
+func TestHandler_audioStream(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID)
+
+	file := &dto.AudioFileRead{
+		Format:    audiotag.FormatOGG,
+		Size:      10,
+		SHA256:    "abc",
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2024, 10, 5, 12, 0, 0, 0, time.UTC), Valid: true},
+	}
+	found := func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID) {
+		s.EXPECT().Open(uuid).Return(file, readSeekNopCloser{strings.NewReader("OggS012345")}, nil)
+	}
+
+	testTable := []struct {
+		name            string
+		inputHeaders    map[string]string
+		mockBehaviour   mockBehaviour
+		expectedCode    int
+		expectedHeaders map[string]string
+		expectedBody    string
+	}{
+		{
+			name:          "200_full",
+			mockBehaviour: found,
+			expectedCode:  200,
+			expectedHeaders: map[string]string{
+				"Content-Type":   "audio/ogg",
+				"Content-Length": "10",
+				"ETag":           `"abc"`,
+				"Accept-Ranges":  "bytes",
+				"Last-Modified":  "Sat, 05 Oct 2024 12:00:00 GMT",
+			},
+			expectedBody: "OggS012345",
+		},
+		{
+			name:          "206_range",
+			inputHeaders:  map[string]string{"Range": "bytes=4-6"},
+			mockBehaviour: found,
+			expectedCode:  206,
+			expectedHeaders: map[string]string{
+				"Content-Type":   "audio/ogg",
+				"Content-Range":  "bytes 4-6/10",
+				"Content-Length": "3",
+			},
+			expectedBody: "012",
+		},
+		{
+			name:          "200_if_range_changed",
+			inputHeaders:  map[string]string{"Range": "bytes=4-6", "If-Range": `"old"`},
+			mockBehaviour: found,
+			expectedCode:  200,
+			expectedBody:  "OggS012345",
+		},
+		{
+			name:          "304_not_modified",
+			inputHeaders:  map[string]string{"If-None-Match": `"abc"`},
+			mockBehaviour: found,
+			expectedCode:  304,
+			expectedBody:  "",
+		},
+		{
+			name:          "416_range_not_satisfiable",
+			inputHeaders:  map[string]string{"Range": "bytes=20-"},
+			mockBehaviour: found,
+			expectedCode:  416,
+			expectedBody:  "invalid range: failed to overlap\n",
+		},
+		{
+			name: "404_no_file",
+			mockBehaviour: func(s *mockservice.MockIAudioFileService, uuid pgtype.UUID) {
+				s.EXPECT().Open(uuid).Return(nil, nil, pgx.ErrNoRows)
+			},
+			expectedCode: 404,
+			expectedBody: `{"error":"no rows in result set", "message":"audio file not found"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			fileService := mockservice.NewMockIAudioFileService(c)
+			testCase.mockBehaviour(fileService, pgtype.UUID{Valid: true})
+
+			services := service.Service{File: fileService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/audios/:uuid/stream", handler.audioStream)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/audios/00000000-0000-0000-0000-000000000000/stream", nil)
+			for k, v := range testCase.inputHeaders {
+				req.Header.Set(k, v)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			for k, v := range testCase.expectedHeaders {
+				assert.Equal(t, v, w.Header().Get(k), k)
+			}
+			if w.Header().Get("Content-Type") == "application/json" {
+				assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+			} else {
+				assert.Equal(t, testCase.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initGenreHandler(r *httprouter.Router) {
+	r.POST("/api/v1/genres", h.genreCreate)
+	r.GET("/api/v1/genres", h.genreList)
+	r.GET("/api/v1/genres/:uuid", h.genreFindByUUID)
+	r.DELETE("/api/v1/genres/:uuid", h.genreDeleteByUUID)
+
+	r.POST("/api/v1/audios/:uuid/genres", h.audioGenreAttach)
+	r.DELETE("/api/v1/audios/:uuid/genres/:genre_uuid", h.audioGenreDetach)
+}
+
+// genreCreate godoc
+// @Tags         Genre API
+// @Summary      Create genre
+// @Description  Create genre, optionally as subgenre of parent. Slug is derived from name
+// @Accept       json
+// @Produce      json
+// @Param Genre body schema.RequestGenreCreate false "Genre base"
+// @Success      201  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /genres [post]
+func (h *Handler) genreCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	genre := schema.RequestGenreCreate{}
+
+	err := json.NewDecoder(r.Body).Decode(&genre)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	genreDTO, err := genre.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	uuid, err := h.s.Genre.Create(genreDTO)
+	if err != nil {
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "genre already exists")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "parent genre does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "create genre err")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: uuid}, "genre created correctly")
+}
+
+// genreList godoc
+// @Tags         Genre API
+// @Summary      List genres
+// @Description  List genres ordered by name
+// @Accept       json
+// @Produce      json
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseGenreRead]
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /genres [get]
+func (h *Handler) genreList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	genres, err := h.s.Genre.ListPag(pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list genres")
+		return
+	}
+
+	genreSchemas := make([]schema.ResponseGenreRead, 0, len(genres))
+	for i := 0; i < len(genres); i++ {
+		g := schema.ResponseGenreRead{}
+		g.FromDTO(&genres[i])
+		genreSchemas = append(genreSchemas, g)
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, genreSchemas, "genres got correctly")
+}
+
+// genreFindByUUID godoc
+// @Tags         Genre API
+// @Summary      Find genre by UUID
+// @Description  Find genre by UUID
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Genre UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseGenreRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /genres/{uuid} [get]
+func (h *Handler) genreFindByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	genre, err := h.s.Genre.Find(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows find")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find genre by uuid")
+		return
+	}
+
+	genreSchema := schema.ResponseGenreRead{}
+	genreSchema.FromDTO(genre)
+
+	WriteResponse(w, http.StatusOK, genreSchema, "genre got correctly")
+}
+
+// genreDeleteByUUID godoc
+// @Tags         Genre API
+// @Summary      Delete genre by UUID
+// @Description  Delete genre by UUID. Genre with subgenres cannot be deleted
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Genre UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /genres/{uuid} [delete]
+func (h *Handler) genreDeleteByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Genre.Delete(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "genre has subgenres")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete genre by uuid")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "genre deleted correctly")
+}
+
+// audioGenreAttach godoc
+// @Tags         Genre API
+// @Summary      Attach genre to audio
+// @Description  Attach genre to audio, attaching twice is not an error
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param Genre body schema.RequestAudioGenreAttach false "Genre"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/genres [post]
+func (h *Handler) audioGenreAttach(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	genre := schema.RequestAudioGenreAttach{}
+	err = json.NewDecoder(r.Body).Decode(&genre)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	genreUUID, err := genre.ToUUID()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	err = h.s.Genre.Attach(uuid, genreUUID)
+	if err != nil {
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio or genre does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on attach genre")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: genreUUID}, "genre attached correctly")
+}
+
+// audioGenreDetach godoc
+// @Tags         Genre API
+// @Summary      Detach genre from audio
+// @Description  Detach genre from audio
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param genre_uuid path string false "Genre UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/genres/{genre_uuid} [delete]
+func (h *Handler) audioGenreDetach(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	genreUUID, err := h.getNamedUUIDParam(ps, "genre_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Genre.Detach(uuid, genreUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on detach genre")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: genreUUID}, "genre detached correctly")
+}
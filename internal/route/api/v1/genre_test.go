@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_genreCreate(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIGenreService, genre *dto.GenreCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.GenreCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_subgenre",
+			inputBody: `{"name": " Post-Punk ", "parent_uuid": "00000000-0000-0000-0000-000000000000"}`,
+			inputDTO:  &dto.GenreCreate{ParentUUID: pgtype.UUID{Valid: true}, Name: "Post-Punk", Slug: "post-punk"},
+			mockBehaviour: func(s *mockservice.MockIGenreService, genre *dto.GenreCreate) {
+				s.EXPECT().Create(genre).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"genre created correctly"}`,
+		},
+		{
+			name:          "400_empty_name",
+			inputBody:     `{"name": " - "}`,
+			mockBehaviour: func(s *mockservice.MockIGenreService, genre *dto.GenreCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'name' is required and must contain letters or digits;", "message":"validation err"}`,
+		},
+		{
+			name:      "409_already_exists",
+			inputBody: `{"name": "Rock"}`,
+			inputDTO:  &dto.GenreCreate{Name: "Rock", Slug: "rock"},
+			mockBehaviour: func(s *mockservice.MockIGenreService, genre *dto.GenreCreate) {
+				s.EXPECT().Create(genre).Return(pgtype.UUID{}, fmt.Errorf("%w: slug", crud.ErrUniqueViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"already exists: slug", "message":"genre already exists"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			genreService := mockservice.NewMockIGenreService(c)
+			testCase.mockBehaviour(genreService, testCase.inputDTO)
+
+			services := service.Service{Genre: genreService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/genres", handler.genreCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/genres", strings.NewReader(testCase.inputBody))
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
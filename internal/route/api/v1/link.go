@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initLinkHandler(r *httprouter.Router) {
+	r.GET("/api/v1/audios/:uuid/links", h.linkListByAudio)
+	r.POST("/api/v1/audios/:uuid/links", h.linkCreate)
+	r.POST("/api/v1/audios/:uuid/links/:link_uuid/primary", h.linkSetPrimary)
+	r.DELETE("/api/v1/audios/:uuid/links/:link_uuid", h.linkDelete)
+}
+
+// linkListByAudio godoc
+// @Tags         Link API
+// @Summary      List audio links
+// @Description  List audio links on streaming platforms, primary link first
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Success      200  {object}  ResponseBase[[]schema.ResponseLinkRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/links [get]
+func (h *Handler) linkListByAudio(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	audioLinks, err := h.s.Link.ListByAudio(uuid)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list audio links")
+		return
+	}
+
+	linkSchemas := make([]schema.ResponseLinkRead, 0, len(audioLinks))
+	for i := 0; i < len(audioLinks); i++ {
+		l := schema.ResponseLinkRead{}
+		l.FromDTO(&audioLinks[i])
+		linkSchemas = append(linkSchemas, l)
+	}
+
+	WriteResponse(w, http.StatusOK, linkSchemas, "links got correctly")
+}
+
+// linkCreate godoc
+// @Tags         Link API
+// @Summary      Add audio link
+// @Description  Add link on streaming platform to audio. Platform is detected from url if omitted.
+// @Description  First link of audio becomes primary, primary link is copied to audio link field
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param X-User-ID header string false "Editor id"
+// @Param Link body schema.RequestLinkCreate false "Link"
+// @Success      201  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/links [post]
+func (h *Handler) linkCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	linkSchema := schema.RequestLinkCreate{}
+	err = json.NewDecoder(r.Body).Decode(&linkSchema)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	linkDTO, err := linkSchema.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+	linkDTO.Editor = h.getUserID(r)
+
+	linkUUID, err := h.s.Link.Create(uuid, linkDTO)
+	if err != nil {
+		if errors.Is(err, crud.ErrUniqueViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "link already exists")
+			return
+		}
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on create link")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, linkUUID, "link created correctly")
+}
+
+// linkSetPrimary godoc
+// @Tags         Link API
+// @Summary      Set primary audio link
+// @Description  Make link primary, audio link field is updated to its url
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param link_uuid path string false "Link UUID"
+// @Param X-User-ID header string false "Editor id"
+// @Success      200  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/links/{link_uuid}/primary [post]
+func (h *Handler) linkSetPrimary(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	linkUUID, err := h.getNamedUUIDParam(ps, "link_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Link.SetPrimary(uuid, linkUUID, h.getUserID(r))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on set primary link")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, linkUUID, "primary link set correctly")
+}
+
+// linkDelete godoc
+// @Tags         Link API
+// @Summary      Delete audio link
+// @Description  Delete audio link. If primary link is deleted the oldest remaining link becomes primary
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param link_uuid path string false "Link UUID"
+// @Param X-User-ID header string false "Editor id"
+// @Success      200  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/links/{link_uuid} [delete]
+func (h *Handler) linkDelete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	linkUUID, err := h.getNamedUUIDParam(ps, "link_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	err = h.s.Link.Delete(uuid, linkUUID, h.getUserID(r))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete link")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, linkUUID, "link deleted correctly")
+}
@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_linkCreate(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockILinkService, uuid pgtype.UUID, link *dto.LinkCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.LinkCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_platform_detected",
+			inputBody: `{"url": " https://music.youtube.com/watch?v=dQw4w9WgXcQ ", "primary": true}`,
+			inputDTO: &dto.LinkCreate{
+				Platform:   "youtube",
+				URL:        "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+				ExternalID: "dQw4w9WgXcQ",
+				IsPrimary:  true,
+				Editor:     "moderator",
+			},
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid pgtype.UUID, link *dto.LinkCreate) {
+				s.EXPECT().Create(uuid, link).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"link created correctly"}`,
+		},
+		{
+			name:          "400_invalid_platform",
+			inputBody:     `{"url": "https://vk.com/audio1", "platform": "vk"}`,
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid pgtype.UUID, link *dto.LinkCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'platform' must be one of: youtube, spotify, apple, yandex, soundcloud, other;", "message":"validation err"}`,
+		},
+		{
+			name:          "400_not_http_url",
+			inputBody:     `{"url": "ftp://example.com/song.mp3"}`,
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid pgtype.UUID, link *dto.LinkCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'url' must be absolute http or https url;", "message":"validation err"}`,
+		},
+		{
+			name:      "409_already_exists",
+			inputBody: `{"url": "https://vk.com/audio1"}`,
+			inputDTO: &dto.LinkCreate{
+				Platform: "other",
+				URL:      "https://vk.com/audio1",
+				Editor:   "moderator",
+			},
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid pgtype.UUID, link *dto.LinkCreate) {
+				s.EXPECT().Create(uuid, link).Return(pgtype.UUID{}, fmt.Errorf("%w: url", crud.ErrUniqueViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"already exists: url", "message":"link already exists"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			linkService := mockservice.NewMockILinkService(c)
+			testCase.mockBehaviour(linkService, pgtype.UUID{Valid: true}, testCase.inputDTO)
+
+			services := service.Service{Link: linkService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/audios/:uuid/links", handler.linkCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/audios/00000000-0000-0000-0000-000000000000/links", strings.NewReader(testCase.inputBody))
+			req.Header.Set("X-User-ID", "moderator")
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_linkSetPrimary(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockILinkService, uuid, linkUUID pgtype.UUID)
+
+	linkUUID := pgtype.UUID{Bytes: [16]byte{15: 1}, Valid: true}
+
+	testTable := []struct {
+		name          string
+		inputPathUUID string
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:          "200_valid_uuid",
+			inputPathUUID: "00000000-0000-0000-0000-000000000001",
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid, linkUUID pgtype.UUID) {
+				s.EXPECT().SetPrimary(uuid, linkUUID, "").Return(nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000001", "message":"primary link set correctly"}`,
+		},
+		{
+			name:          "200_no_rows",
+			inputPathUUID: "00000000-0000-0000-0000-000000000001",
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid, linkUUID pgtype.UUID) {
+				s.EXPECT().SetPrimary(uuid, linkUUID, "").Return(pgx.ErrNoRows)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{}, "message":"no rows updated"}`,
+		},
+		{
+			name:          "400_invalid_link_uuid",
+			inputPathUUID: "primary",
+			mockBehaviour: func(s *mockservice.MockILinkService, uuid, linkUUID pgtype.UUID) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"cannot parse UUID primary", "message":"invalid uuid in path param"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			linkService := mockservice.NewMockILinkService(c)
+			testCase.mockBehaviour(linkService, pgtype.UUID{Valid: true}, linkUUID)
+
+			services := service.Service{Link: linkService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/audios/:uuid/links/:link_uuid/primary", handler.linkSetPrimary)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/audios/00000000-0000-0000-0000-000000000000/links/"+testCase.inputPathUUID+"/primary", nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
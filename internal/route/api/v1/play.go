@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"io"
+	"net/http"
+)
+
+func (h *Handler) initPlayHandler(r *httprouter.Router) {
+	r.POST("/api/v1/audios/:uuid/plays", h.playRecord)
+	r.GET("/api/v1/me/history", h.playHistory)
+}
+
+// playRecord godoc
+// @Tags         Play API
+// @Summary      Record play
+// @Description  Record listen of audio by user from X-User-ID header and count it in audio play_count.
+// @Description  Body may be omitted, played_at defaults to now and cannot be older than 24 hours. Plays of the same audio by user
+// @Description  within dedup window of played_at or of the time play was recorded are recorded once: already recorded play is returned with 200
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Audio UUID"
+// @Param Play body schema.RequestPlayCreate false "Play"
+// @Success      200  {object}  ResponseBase[schema.ResponsePlayRead]
+// @Success      201  {object}  ResponseBase[schema.ResponsePlayRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/plays [post]
+func (h *Handler) playRecord(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	userID := h.getUserID(r)
+	if userID == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	play := schema.RequestPlayCreate{}
+	err = json.NewDecoder(r.Body).Decode(&play)
+	if err != nil && !errors.Is(err, io.EOF) {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	playDTO, err := play.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+	playDTO.UserID = userID
+
+	readPlay, created, err := h.s.Play.Record(uuid, playDTO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on record play")
+		return
+	}
+
+	playSchema := schema.ResponsePlayRead{}
+	playSchema.FromDTO(readPlay)
+
+	if !created {
+		WriteResponse(w, http.StatusOK, playSchema, "play already recorded")
+		return
+	}
+	WriteResponse(w, http.StatusCreated, playSchema, "play recorded correctly")
+}
+
+// playHistory godoc
+// @Tags         Play API
+// @Summary      Listening history
+// @Description  List audios played by user from X-User-ID header, recent plays first
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponsePlayHistoryRead]
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /me/history [get]
+func (h *Handler) playHistory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := h.getUserID(r)
+	if userID == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	history, err := h.s.Play.History(userID, pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list play history")
+		return
+	}
+
+	historySchemas := make([]schema.ResponsePlayHistoryRead, 0, len(history))
+	for i := 0; i < len(history); i++ {
+		p := schema.ResponsePlayHistoryRead{}
+		p.FromDTO(&history[i])
+		historySchemas = append(historySchemas, p)
+	}
+
+	err = h.markFavorites(r, len(historySchemas), func(i int) *schema.ResponseAudioRead { return &historySchemas[i].ResponseAudioRead })
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find favorites")
+		return
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, historySchemas, "history got correctly")
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_playRecord(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate)
+
+	playedAt := time.Date(2024, 10, 5, 12, 57, 19, 0, time.UTC)
+	recentPlayedAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	readPlay := &dto.PlayRead{
+		UUID:       pgtype.UUID{Valid: true},
+		UserID:     "user1",
+		AudioUUID:  pgtype.UUID{Valid: true},
+		PlayedAt:   pgtype.Timestamptz{Time: playedAt, Valid: true},
+		DurationMs: pgtype.Int4{Int32: 180000, Valid: true},
+	}
+
+	testTable := []struct {
+		name          string
+		userID        string
+		inputBody     string
+		inputDTO      *dto.PlayCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_recorded",
+			userID:    "user1",
+			inputBody: `{"played_at": "` + recentPlayedAt.Format(time.RFC3339) + `", "duration_ms": 180000}`,
+			inputDTO: &dto.PlayCreate{
+				UserID:     "user1",
+				PlayedAt:   pgtype.Timestamptz{Time: recentPlayedAt, Valid: true},
+				DurationMs: pgtype.Int4{Int32: 180000, Valid: true},
+			},
+			mockBehaviour: func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate) {
+				s.EXPECT().Record(uuid, play).Return(readPlay, true, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":{"uuid":"00000000-0000-0000-0000-000000000000", "audio_uuid":"00000000-0000-0000-0000-000000000000", "played_at":"2024-10-05T12:57:19Z", "duration_ms":180000}, "message":"play recorded correctly"}`,
+		},
+		{
+			name:     "200_deduplicated_without_body",
+			userID:   "user1",
+			inputDTO: &dto.PlayCreate{UserID: "user1"},
+			mockBehaviour: func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate) {
+				s.EXPECT().Record(uuid, play).Return(readPlay, false, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{"uuid":"00000000-0000-0000-0000-000000000000", "audio_uuid":"00000000-0000-0000-0000-000000000000", "played_at":"2024-10-05T12:57:19Z", "duration_ms":180000}, "message":"play already recorded"}`,
+		},
+		{
+			name:          "400_invalid_input",
+			userID:        "user1",
+			inputBody:     `{"played_at": "3024-10-05T12:57:19Z", "duration_ms": -1}`,
+			mockBehaviour: func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'played_at' cannot be in the future;'duration_ms' must be milliseconds between 0 and 86400000;", "message":"validation err"}`,
+		},
+		{
+			name:          "400_played_at_too_old",
+			userID:        "user1",
+			inputBody:     `{"played_at": "2024-10-05T12:57:19Z"}`,
+			mockBehaviour: func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'played_at' cannot be older than 24 hours;", "message":"validation err"}`,
+		},
+		{
+			name:          "401_no_user",
+			mockBehaviour: func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate) {},
+			expectedCode:  401,
+			expectedBody:  `{"error":"X-User-ID header is required", "message":"user is not identified"}`,
+		},
+		{
+			name:     "409_unknown_audio",
+			userID:   "user1",
+			inputDTO: &dto.PlayCreate{UserID: "user1"},
+			mockBehaviour: func(s *mockservice.MockIPlayService, uuid pgtype.UUID, play *dto.PlayCreate) {
+				s.EXPECT().Record(uuid, play).Return(nil, false, pgx.ErrNoRows)
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"no rows in result set", "message":"audio does not exist"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			playService := mockservice.NewMockIPlayService(c)
+			testCase.mockBehaviour(playService, pgtype.UUID{Valid: true}, testCase.inputDTO)
+
+			services := service.Service{Play: playService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/audios/:uuid/plays", handler.playRecord)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/audios/00000000-0000-0000-0000-000000000000/plays", strings.NewReader(testCase.inputBody))
+			if testCase.userID != "" {
+				req.Header.Set("X-User-ID", testCase.userID)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
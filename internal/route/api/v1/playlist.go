@@ -0,0 +1,436 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"eMobile/internal/service/playlistService"
+	"eMobile/pkg/playlistfile"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initPlaylistHandler(r *httprouter.Router) {
+	r.POST("/api/v1/playlists", h.playlistCreate)
+	r.GET("/api/v1/playlists", h.playlistList)
+	r.GET("/api/v1/playlists/:uuid", h.playlistFindByUUID)
+	r.PATCH("/api/v1/playlists/:uuid", h.playlistUpdateByUUID)
+	r.DELETE("/api/v1/playlists/:uuid", h.playlistDeleteByUUID)
+
+	r.POST("/api/v1/playlists/:uuid/entries", h.playlistEntryAdd)
+	r.DELETE("/api/v1/playlists/:uuid/entries/:entry_uuid", h.playlistEntryRemove)
+	r.POST("/api/v1/playlists/:uuid/entries/:entry_uuid/move", h.playlistEntryMove)
+}
+
+// playlistCreate godoc
+// @Tags         Playlist API
+// @Summary      Create playlist
+// @Description  Create playlist owned by user from X-User-ID header
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param Playlist body schema.RequestPlaylistCreate false "Playlist base"
+// @Success      201  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists [post]
+func (h *Handler) playlistCreate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	owner := h.getUserID(r)
+	if owner == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	playlist := schema.RequestPlaylistCreate{}
+	err := json.NewDecoder(r.Body).Decode(&playlist)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	playlistDTO, err := playlist.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+	playlistDTO.Owner = owner
+
+	uuid, err := h.s.Playlist.Create(playlistDTO)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "create playlist err")
+		return
+	}
+
+	WriteResponse(w, http.StatusCreated, schema.ResponseUUID{UUID: uuid}, "playlist created correctly")
+}
+
+// playlistList godoc
+// @Tags         Playlist API
+// @Summary      List playlists
+// @Description  List playlists of owner ordered by last update. Owner defaults to user from
+// @Description  X-User-ID header, all playlists are listed if neither is set
+// @Accept       json
+// @Produce      json
+// @Param owner 	query string false "owner user id"
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponsePlaylistRead]
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists [get]
+func (h *Handler) playlistList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	owner := r.URL.Query().Get("owner")
+	if owner == "" {
+		owner = h.getUserID(r)
+	}
+
+	playlists, err := h.s.Playlist.ListByOwner(owner, pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list playlists")
+		return
+	}
+
+	playlistSchemas := make([]schema.ResponsePlaylistRead, 0, len(playlists))
+	for i := 0; i < len(playlists); i++ {
+		p := schema.ResponsePlaylistRead{}
+		p.FromDTO(&playlists[i])
+		playlistSchemas = append(playlistSchemas, p)
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, playlistSchemas, "playlists got correctly")
+}
+
+// playlistFindByUUID godoc
+// @Tags         Playlist API
+// @Summary      Find playlist by UUID
+// @Description  Find playlist by UUID with audios ordered by position. Accept audio/x-mpegurl returns
+// @Description  extended M3U playlist, application/xspf+xml XSPF playlist. Audios without link are left out of M3U
+// @Accept       json
+// @Produce      json,audio/x-mpegurl,application/xspf+xml
+// @Param X-User-ID header string false "User ID, audios are marked with is_favorite"
+// @Param uuid path string false "Playlist UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponsePlaylistReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists/{uuid} [get]
+func (h *Handler) playlistFindByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	playlist, err := h.s.Playlist.Find(uuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows find")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find playlist by uuid")
+		return
+	}
+
+	if contentType := exportContentType(r); contentType != "" {
+		tracks := make([]playlistfile.Track, 0, len(playlist.Entries))
+		for i := 0; i < len(playlist.Entries); i++ {
+			tracks = append(tracks, audioTrack(&playlist.Entries[i].AudioRead))
+		}
+		h.writePlaylistFile(w, contentType, playlist.Name, tracks)
+		return
+	}
+
+	playlistSchema := schema.ResponsePlaylistReadFull{}
+	playlistSchema.FromDTOFull(playlist)
+
+	entries := playlistSchema.Entries
+	err = h.markFavorites(r, len(entries), func(i int) *schema.ResponseAudioRead { return &entries[i].ResponseAudioRead })
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on find favorites")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, playlistSchema, "playlist got correctly")
+}
+
+// playlistUpdateByUUID godoc
+// @Tags         Playlist API
+// @Summary      Update playlist by UUID
+// @Description  Rename playlist or change its description, allowed to owner only
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Playlist UUID"
+// @Param Playlist body schema.RequestPlaylistUpdate false "Playlist update base"
+// @Success      200  {object}  ResponseBase[schema.ResponsePlaylistRead]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      403  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists/{uuid} [patch]
+func (h *Handler) playlistUpdateByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	owner := h.getUserID(r)
+	if owner == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	playlist := schema.RequestPlaylistUpdate{}
+	err = json.NewDecoder(r.Body).Decode(&playlist)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	playlistDTO, err := playlist.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	readPlaylistDTO, err := h.s.Playlist.Update(uuid, owner, playlistDTO)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows updated")
+			return
+		}
+		if errors.Is(err, playlistService.ErrNotOwner) {
+			WriteResponseErr(w, http.StatusForbidden, err, "playlist belongs to another user")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on update playlist")
+		return
+	}
+
+	readPlaylistSchema := schema.ResponsePlaylistRead{}
+	readPlaylistSchema.FromDTO(readPlaylistDTO)
+
+	WriteResponse(w, http.StatusOK, readPlaylistSchema, "playlist updated correctly")
+}
+
+// playlistDeleteByUUID godoc
+// @Tags         Playlist API
+// @Summary      Delete playlist by UUID
+// @Description  Delete playlist by UUID, allowed to owner only. Playlist audios are kept
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Playlist UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponseUUID]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      403  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists/{uuid} [delete]
+func (h *Handler) playlistDeleteByUUID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	owner := h.getUserID(r)
+	if owner == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	err = h.s.Playlist.Delete(uuid, owner)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		if errors.Is(err, playlistService.ErrNotOwner) {
+			WriteResponseErr(w, http.StatusForbidden, err, "playlist belongs to another user")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on delete playlist by uuid")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, schema.ResponseUUID{UUID: uuid}, "playlist deleted correctly")
+}
+
+// playlistEntryAdd godoc
+// @Tags         Playlist API
+// @Summary      Add audio to playlist
+// @Description  Add audio to playlist at position, following entries are shifted. Omitted position
+// @Description  appends audio to the end. The same audio may be added several times
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Playlist UUID"
+// @Param Entry body schema.RequestPlaylistEntryAdd false "Entry"
+// @Success      200  {object}  ResponseBase[schema.ResponsePlaylistReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      403  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists/{uuid}/entries [post]
+func (h *Handler) playlistEntryAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	owner := h.getUserID(r)
+	if owner == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	entry := schema.RequestPlaylistEntryAdd{}
+	err = json.NewDecoder(r.Body).Decode(&entry)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	entryDTO, err := entry.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	playlist, err := h.s.Playlist.AddEntry(uuid, owner, entryDTO)
+	if err != nil {
+		h.writePlaylistEntryErr(w, err, "no rows updated", "error on add playlist entry")
+		return
+	}
+
+	playlistSchema := schema.ResponsePlaylistReadFull{}
+	playlistSchema.FromDTOFull(playlist)
+
+	WriteResponse(w, http.StatusOK, playlistSchema, "entry added correctly")
+}
+
+// playlistEntryRemove godoc
+// @Tags         Playlist API
+// @Summary      Remove entry from playlist
+// @Description  Remove entry from playlist, following entries are shifted
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Playlist UUID"
+// @Param entry_uuid path string false "Entry UUID"
+// @Success      200  {object}  ResponseBase[schema.ResponsePlaylistReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      403  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists/{uuid}/entries/{entry_uuid} [delete]
+func (h *Handler) playlistEntryRemove(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	entryUUID, err := h.getNamedUUIDParam(ps, "entry_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	owner := h.getUserID(r)
+	if owner == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	playlist, err := h.s.Playlist.RemoveEntry(uuid, entryUUID, owner)
+	if err != nil {
+		h.writePlaylistEntryErr(w, err, "no rows deleted", "error on remove playlist entry")
+		return
+	}
+
+	playlistSchema := schema.ResponsePlaylistReadFull{}
+	playlistSchema.FromDTOFull(playlist)
+
+	WriteResponse(w, http.StatusOK, playlistSchema, "entry removed correctly")
+}
+
+// playlistEntryMove godoc
+// @Tags         Playlist API
+// @Summary      Move playlist entry
+// @Description  Move entry to position, entries between old and new positions are shifted.
+// @Description  Zero or out of range position moves entry to the end
+// @Accept       json
+// @Produce      json
+// @Param X-User-ID header string true "User ID"
+// @Param uuid path string false "Playlist UUID"
+// @Param entry_uuid path string false "Entry UUID"
+// @Param Position body schema.RequestPlaylistEntryMove false "New position"
+// @Success      200  {object}  ResponseBase[schema.ResponsePlaylistReadFull]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      401  {object}  ResponseBaseErr
+// @Failure      403  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /playlists/{uuid}/entries/{entry_uuid}/move [post]
+func (h *Handler) playlistEntryMove(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	entryUUID, err := h.getNamedUUIDParam(ps, "entry_uuid")
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+	owner := h.getUserID(r)
+	if owner == "" {
+		WriteResponseErr(w, http.StatusUnauthorized, errUserRequired, "user is not identified")
+		return
+	}
+
+	move := schema.RequestPlaylistEntryMove{}
+	err = json.NewDecoder(r.Body).Decode(&move)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	position, err := move.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation error")
+		return
+	}
+
+	playlist, err := h.s.Playlist.MoveEntry(uuid, entryUUID, owner, position)
+	if err != nil {
+		h.writePlaylistEntryErr(w, err, "no rows updated", "error on move playlist entry")
+		return
+	}
+
+	playlistSchema := schema.ResponsePlaylistReadFull{}
+	playlistSchema.FromDTOFull(playlist)
+
+	WriteResponse(w, http.StatusOK, playlistSchema, "entry moved correctly")
+}
+
+// writePlaylistEntryErr
+// write response for errors of playlist entry changes
+func (h *Handler) writePlaylistEntryErr(w http.ResponseWriter, err error, noRows, message string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		WriteResponse(w, http.StatusOK, struct{}{}, noRows)
+	case errors.Is(err, playlistService.ErrNotOwner):
+		WriteResponseErr(w, http.StatusForbidden, err, "playlist belongs to another user")
+	case errors.Is(err, crud.ErrForeignKeyViolation):
+		WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+	default:
+		WriteResponseErr(w, http.StatusInternalServerError, err, message)
+	}
+}
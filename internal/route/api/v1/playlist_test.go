@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/internal/service/playlistService"
+	"eMobile/pkg/logging"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_playlistCreate(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIPlaylistService, playlist *dto.PlaylistCreate)
+
+	testTable := []struct {
+		name          string
+		userID        string
+		inputBody     string
+		inputDTO      *dto.PlaylistCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "201_valid_input",
+			userID:    "user1",
+			inputBody: `{"name": " Road trip ", "description": "long drives"}`,
+			inputDTO:  &dto.PlaylistCreate{Owner: "user1", Name: "Road trip", Description: "long drives"},
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, playlist *dto.PlaylistCreate) {
+				s.EXPECT().Create(playlist).Return(pgtype.UUID{Valid: true}, nil)
+			},
+			expectedCode: 201,
+			expectedBody: `{"data":"00000000-0000-0000-0000-000000000000", "message":"playlist created correctly"}`,
+		},
+		{
+			name:          "400_empty_name",
+			userID:        "user1",
+			inputBody:     `{"name": " "}`,
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, playlist *dto.PlaylistCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'name' is required and cannot be empty;", "message":"validation err"}`,
+		},
+		{
+			name:          "401_no_user",
+			inputBody:     `{"name": "Road trip"}`,
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, playlist *dto.PlaylistCreate) {},
+			expectedCode:  401,
+			expectedBody:  `{"error":"X-User-ID header is required", "message":"user is not identified"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			playlists := mockservice.NewMockIPlaylistService(c)
+			testCase.mockBehaviour(playlists, testCase.inputDTO)
+
+			services := service.Service{Playlist: playlists}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/playlists", handler.playlistCreate)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/playlists", strings.NewReader(testCase.inputBody))
+			if testCase.userID != "" {
+				req.Header.Set("X-User-ID", testCase.userID)
+			}
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_playlistEntryAdd(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIPlaylistService, uuid pgtype.UUID, entry *dto.PlaylistEntryCreate)
+
+	testTable := []struct {
+		name          string
+		inputBody     string
+		inputDTO      *dto.PlaylistEntryCreate
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:      "200_entry_added",
+			inputBody: `{"audio_uuid": "00000000-0000-0000-0000-000000000000"}`,
+			inputDTO:  &dto.PlaylistEntryCreate{AudioUUID: pgtype.UUID{Valid: true}},
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, uuid pgtype.UUID, entry *dto.PlaylistEntryCreate) {
+				s.EXPECT().AddEntry(uuid, "user1", entry).Return(&dto.PlaylistReadFull{
+					PlaylistRead: dto.PlaylistRead{
+						UUID:       uuid,
+						Owner:      "user1",
+						Name:       "playlist1",
+						EntryCount: 1,
+					},
+					Entries: []dto.PlaylistEntryRead{
+						{
+							AudioRead: dto.AudioRead{
+								UUID:       pgtype.UUID{Valid: true},
+								ArtistUUID: pgtype.UUID{Valid: true},
+								Group:      "group1",
+								Song:       "song1",
+							},
+							EntryUUID: pgtype.UUID{Valid: true},
+							Position:  1,
+						},
+					},
+				}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data": {"uuid":"00000000-0000-0000-0000-000000000000", "owner":"user1", "name":"playlist1", "description":"", "entry_count":1, "created_at":null, "updated_at":null, "entries": [{"uuid":"00000000-0000-0000-0000-000000000000", "artist_uuid":"00000000-0000-0000-0000-000000000000", "group":"group1", "song":"song1", "release_date":null, "link":"", "created_at":null, "updated_at":null, "entry_uuid":"00000000-0000-0000-0000-000000000000", "position":1, "added_at":null}]}, "message":"entry added correctly"}`,
+		},
+		{
+			name:          "400_negative_position",
+			inputBody:     `{"audio_uuid": "00000000-0000-0000-0000-000000000000", "position": -1}`,
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, uuid pgtype.UUID, entry *dto.PlaylistEntryCreate) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'position' cannot be negative;", "message":"validation error"}`,
+		},
+		{
+			name:      "403_not_owner",
+			inputBody: `{"audio_uuid": "00000000-0000-0000-0000-000000000000"}`,
+			inputDTO:  &dto.PlaylistEntryCreate{AudioUUID: pgtype.UUID{Valid: true}},
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, uuid pgtype.UUID, entry *dto.PlaylistEntryCreate) {
+				s.EXPECT().AddEntry(uuid, "user1", entry).Return(nil, playlistService.ErrNotOwner)
+			},
+			expectedCode: 403,
+			expectedBody: `{"error":"playlist belongs to another user", "message":"playlist belongs to another user"}`,
+		},
+		{
+			name:      "409_unknown_audio",
+			inputBody: `{"audio_uuid": "00000000-0000-0000-0000-000000000000"}`,
+			inputDTO:  &dto.PlaylistEntryCreate{AudioUUID: pgtype.UUID{Valid: true}},
+			mockBehaviour: func(s *mockservice.MockIPlaylistService, uuid pgtype.UUID, entry *dto.PlaylistEntryCreate) {
+				s.EXPECT().AddEntry(uuid, "user1", entry).Return(nil, fmt.Errorf("%w: audio_uuid", crud.ErrForeignKeyViolation))
+			},
+			expectedCode: 409,
+			expectedBody: `{"error":"referenced by other rows: audio_uuid", "message":"audio does not exist"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			playlists := mockservice.NewMockIPlaylistService(c)
+			testCase.mockBehaviour(playlists, pgtype.UUID{Valid: true}, testCase.inputDTO)
+
+			services := service.Service{Playlist: playlists}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.POST("/playlists/:uuid/entries", handler.playlistEntryAdd)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/playlists/00000000-0000-0000-0000-000000000000/entries", strings.NewReader(testCase.inputBody))
+			req.Header.Set("X-User-ID", "user1")
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"eMobile/internal/dto"
+	"eMobile/internal/schema"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initStatsHandler(r *httprouter.Router) {
+	r.GET("/api/v1/stats", h.statsAudios)
+}
+
+// statsAudios godoc
+// @Tags         Stats API
+// @Summary      Catalog statistics
+// @Description  Count audios per group and release year, audios without lyrics, link or release date,
+// @Description  and average verses per audio with lyrics. Audios are filtered like in audio list
+// @Accept       json
+// @Produce      json
+// @Param group 	query string false "exact search"
+// @Param song 		query string false "full-text-search (english)"
+// @Param after 	query string false "after(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods"
+// @Param before 	query string false "before(include) search: 2012, 2012-09 or 2012-09-23, matches overlapping release periods"
+// @Param link 		query string false "any link of audio, matched in canonical form"
+// @Param lyric 	query string false "full-text-search (english)"
+// @Param credit 	query string false "credited person, matched by canonical name"
+// @Param credit_role 	query string false "credit role, requires credit" Enums(performer, featured, writer, composer, lyricist, producer)
+// @Param genre 	query []string false "genre slug, subgenres match too" collectionFormat(multi)
+// @Param genre_mode 	query string false "match any or all genres" Enums(any, all)
+// @Param tag 		query []string false "tag" collectionFormat(multi)
+// @Param tag_mode 	query string false "match any or all tags" Enums(any, all)
+// @Param platform 	query string false "has link on platform" Enums(youtube, spotify, apple, yandex, soundcloud, other)
+// @Param duration_min 	query int false "min duration (include), milliseconds"
+// @Param duration_max 	query int false "max duration (include), milliseconds"
+// @Param isrc 		query string false "exact search, hyphens allowed"
+// @Param explicit 	query boolean false "explicit content or not"
+// @Success      200  {object}  ResponseBase[schema.ResponseAudioStats]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /stats [get]
+func (h *Handler) statsAudios(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	filter := schema.RequestAudioFilter{}
+	filter.ScanQuery(r.URL)
+	filterDTO, err := filter.ToDTO()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+	if filterDTO == nil {
+		filterDTO = &dto.AudioFilter{}
+	}
+
+	stats, err := h.s.Audio.Stats(filterDTO)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on audio stats")
+		return
+	}
+
+	statsSchema := schema.ResponseAudioStats{}
+	statsSchema.FromDTO(stats)
+
+	WriteResponse(w, http.StatusOK, statsSchema, "stats got correctly")
+}
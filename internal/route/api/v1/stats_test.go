@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/dto"
+	"eMobile/internal/service"
+	mockservice "eMobile/internal/service/mocks"
+	"eMobile/pkg/logging"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler_statsAudios(t *testing.T) {
+	type mockBehaviour func(s *mockservice.MockIAudioService, filter *dto.AudioFilter)
+
+	stats := &dto.AudioStats{
+		Audios:             3,
+		WithoutLyrics:      1,
+		WithoutLink:        2,
+		WithoutReleaseDate: 0,
+		AvgVerses:          2.5,
+		ByGroup:            []dto.GroupAudioStat{{Group: "group1", Audios: 2}, {Group: "group2", Audios: 1}},
+		ByYear:             []dto.YearAudioStat{{Year: 2006, Audios: 1}, {Year: 2012, Audios: 2}},
+	}
+
+	testTable := []struct {
+		name          string
+		query         string
+		inputDTO      *dto.AudioFilter
+		mockBehaviour mockBehaviour
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:     "200_without_filter",
+			inputDTO: &dto.AudioFilter{},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter) {
+				s.EXPECT().Stats(filter).Return(stats, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{"audios":3, "without_lyrics":1, "without_link":2, "without_release_date":0, "avg_verses":2.5,
+				"by_group":[{"group":"group1", "audios":2}, {"group":"group2", "audios":1}],
+				"by_year":[{"year":2006, "audios":1}, {"year":2012, "audios":2}]}, "message":"stats got correctly"}`,
+		},
+		{
+			name:     "200_filtered_empty",
+			query:    "?group=group3",
+			inputDTO: &dto.AudioFilter{Group: sql.NullString{String: "group3", Valid: true}},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter) {
+				s.EXPECT().Stats(filter).Return(&dto.AudioStats{}, nil)
+			},
+			expectedCode: 200,
+			expectedBody: `{"data":{"audios":0, "without_lyrics":0, "without_link":0, "without_release_date":0, "avg_verses":0,
+				"by_group":[], "by_year":[]}, "message":"stats got correctly"}`,
+		},
+		{
+			name:          "400_invalid_filter",
+			query:         "?duration_min=abc",
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter) {},
+			expectedCode:  400,
+			expectedBody:  `{"error":"'duration_min' must be milliseconds between 0 and 86400000", "message":"validation err"}`,
+		},
+		{
+			name:     "500_service_error",
+			inputDTO: &dto.AudioFilter{},
+			mockBehaviour: func(s *mockservice.MockIAudioService, filter *dto.AudioFilter) {
+				s.EXPECT().Stats(filter).Return(nil, errors.New("some error"))
+			},
+			expectedCode: 500,
+			expectedBody: `{"error":"some error", "message":"error on audio stats"}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init Deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+			audioService := mockservice.NewMockIAudioService(c)
+			testCase.mockBehaviour(audioService, testCase.inputDTO)
+
+			services := service.Service{Audio: audioService}
+			handler := NewHandler(Deps{
+				Service: services,
+				Logger:  logging.GetLoggerTest(),
+			})
+
+			//Test server
+			r := httprouter.New()
+			r.GET("/stats", handler.statsAudios)
+
+			//http test
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/stats"+testCase.query, nil)
+
+			//Perform request
+			r.ServeHTTP(w, req)
+
+			//Assert
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			assert.JSONEq(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"database/sql"
+	"eMobile/internal/crud"
+	"eMobile/internal/schema"
+	"eMobile/pkg/canon"
+	"encoding/json"
+	"errors"
+	"github.com/julienschmidt/httprouter"
+	"net/http"
+)
+
+func (h *Handler) initTagHandler(r *httprouter.Router) {
+	r.GET("/api/v1/tags", h.tagList)
+
+	r.POST("/api/v1/audios/:uuid/tags", h.audioTagAttach)
+	r.DELETE("/api/v1/audios/:uuid/tags/:tag", h.audioTagDetach)
+}
+
+// tagList godoc
+// @Tags         Tag API
+// @Summary      List tags
+// @Description  List tags with number of tagged audios, most used first
+// @Accept       json
+// @Produce      json
+// @Param limit 	query int false "rows limit"
+// @Param offset 	query int false "rows offset"
+// @Success      200  {object}  ResponseBasePaginated[schema.ResponseTagRead]
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /tags [get]
+func (h *Handler) tagList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	pag := h.getPagination(r.URL)
+	nextPag := crud.Pagination{
+		Offset: pag.Offset + pag.Limit,
+		Limit:  pag.Limit,
+	}
+
+	tags, err := h.s.Tag.ListPag(pag)
+	if err != nil {
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on list tags")
+		return
+	}
+
+	tagSchemas := make([]schema.ResponseTagRead, 0, len(tags))
+	for i := 0; i < len(tags); i++ {
+		t := schema.ResponseTagRead{}
+		t.FromDTO(&tags[i])
+		tagSchemas = append(tagSchemas, t)
+	}
+
+	WriteResponsePaginated(w, http.StatusOK, nextPag, tagSchemas, "tags got correctly")
+}
+
+// audioTagAttach godoc
+// @Tags         Tag API
+// @Summary      Tag audio
+// @Description  Attach free-form tag to audio. Tag is stored lower case and created if not exists
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param Tag body schema.RequestAudioTagAttach false "Tag"
+// @Success      200  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      409  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/tags [post]
+func (h *Handler) audioTagAttach(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	tagSchema := schema.RequestAudioTagAttach{}
+	err = json.NewDecoder(r.Body).Decode(&tagSchema)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "read body err")
+		return
+	}
+
+	tag, err := tagSchema.ToTag()
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "validation err")
+		return
+	}
+
+	err = h.s.Tag.Attach(uuid, tag)
+	if err != nil {
+		if errors.Is(err, crud.ErrForeignKeyViolation) {
+			WriteResponseErr(w, http.StatusConflict, err, "audio does not exist")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on attach tag")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, tag, "tag attached correctly")
+}
+
+// audioTagDetach godoc
+// @Tags         Tag API
+// @Summary      Untag audio
+// @Description  Detach tag from audio
+// @Accept       json
+// @Produce      json
+// @Param uuid path string false "Audio UUID"
+// @Param tag path string false "Tag"
+// @Success      200  {object}  ResponseBase[string]
+// @Failure      400  {object}  ResponseBaseErr
+// @Failure      500  {object}	ResponseBaseErr
+// @Router       /audios/{uuid}/tags/{tag} [delete]
+func (h *Handler) audioTagDetach(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	uuid, err := h.getUUIDParam(ps)
+	if err != nil {
+		WriteResponseErr(w, http.StatusBadRequest, err, "invalid uuid in path param")
+		return
+	}
+
+	tag := canon.Tag(ps.ByName("tag"))
+	err = h.s.Tag.Detach(uuid, tag)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			WriteResponse(w, http.StatusOK, struct{}{}, "no rows deleted")
+			return
+		}
+		WriteResponseErr(w, http.StatusInternalServerError, err, "error on detach tag")
+		return
+	}
+
+	WriteResponse(w, http.StatusOK, tag, "tag detached correctly")
+}
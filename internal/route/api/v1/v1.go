@@ -5,8 +5,10 @@ import (
 	"eMobile/internal/crud"
 	"eMobile/internal/service"
 	"eMobile/pkg/logging"
+	"errors"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/julienschmidt/httprouter"
+	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -33,6 +35,19 @@ func NewHandler(d Deps) *Handler {
 
 func (h *Handler) Init(r *httprouter.Router) {
 	h.initAudioHandler(r)
+	h.initArtistHandler(r)
+	h.initAlbumHandler(r)
+	h.initCreditHandler(r)
+	h.initGenreHandler(r)
+	h.initTagHandler(r)
+	h.initLinkHandler(r)
+	h.initCoverHandler(r)
+	h.initFileHandler(r)
+	h.initPlaylistHandler(r)
+	h.initFavoriteHandler(r)
+	h.initPlayHandler(r)
+	h.initChartHandler(r)
+	h.initStatsHandler(r)
 }
 
 func (h *Handler) getPagination(u *url.URL) crud.Pagination {
@@ -59,8 +74,25 @@ func (h *Handler) getPagination(u *url.URL) crud.Pagination {
 }
 
 func (h *Handler) getUUIDParam(params httprouter.Params) (pgtype.UUID, error) {
-	strUUID := params.ByName("uuid")
+	return h.getNamedUUIDParam(params, "uuid")
+}
+
+// getNamedUUIDParam
+// parse uuid path param with given name
+func (h *Handler) getNamedUUIDParam(params httprouter.Params, name string) (pgtype.UUID, error) {
+	strUUID := params.ByName(name)
 	uuid := pgtype.UUID{}
 	err := uuid.Scan(strUUID)
 	return uuid, err
 }
+
+// errUserRequired
+// returned when request has no X-User-ID header
+var errUserRequired = errors.New("X-User-ID header is required")
+
+// getUserID
+// return id of user making request, set by gateway in X-User-ID header.
+// Empty string if not set
+func (h *Handler) getUserID(r *http.Request) string {
+	return r.Header.Get("X-User-ID")
+}
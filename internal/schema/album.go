@@ -0,0 +1,240 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+	"time"
+)
+
+// validAlbumType
+// return true if t is one of dto album types
+func validAlbumType(t string) bool {
+	switch t {
+	case dto.AlbumTypeAlbum, dto.AlbumTypeSingle, dto.AlbumTypeEP, dto.AlbumTypeCompilation:
+		return true
+	}
+	return false
+}
+
+type RequestAlbumCreate struct {
+	Title       string  `json:"title" example:"Abbey Road"`
+	ArtistUUID  string  `json:"artist_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Type        string  `json:"type" enums:"album,single,ep,compilation" example:"album"`
+	ReleaseDate *string `json:"release_date,omitempty" example:"1969-09-26"`
+}
+
+// ToDTO
+// validate create request. Type defaults to album, artist may be
+// omitted for compilations only
+func (schema *RequestAlbumCreate) ToDTO() (*dto.AlbumCreate, error) {
+	albumDTO := &dto.AlbumCreate{
+		Title: strings.TrimSpace(schema.Title),
+		Type:  schema.Type,
+	}
+
+	errStr := ""
+	if albumDTO.Title == "" {
+		errStr += "'title' is required and cannot be empty;"
+	}
+	if albumDTO.Type == "" {
+		albumDTO.Type = dto.AlbumTypeAlbum
+	} else if !validAlbumType(albumDTO.Type) {
+		errStr += "'type' must be one of: album, single, ep, compilation;"
+	}
+	if schema.ArtistUUID != "" {
+		uuid, ok := parseUUID(schema.ArtistUUID)
+		if !ok {
+			errStr += "'artist_uuid' must be valid uuid;"
+		}
+		albumDTO.ArtistUUID = uuid
+	} else if albumDTO.Type != dto.AlbumTypeCompilation {
+		errStr += "'artist_uuid' is required for non compilation album;"
+	}
+	if schema.ReleaseDate != nil {
+		t, err := time.Parse("2006-01-02", *schema.ReleaseDate)
+		if err != nil {
+			errStr += "invalid date format, example: 2006-09-25;"
+		} else {
+			albumDTO.ReleaseDate = pgtype.Date{Time: t, Valid: true}
+		}
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return albumDTO, nil
+}
+
+type RequestAlbumUpdate struct {
+	Title       *string `json:"title" example:"Abbey Road"`
+	ArtistUUID  *string `json:"artist_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Type        *string `json:"type" enums:"album,single,ep,compilation" example:"album"`
+	ReleaseDate *string `json:"release_date" example:"1969-09-26"`
+}
+
+func (schema *RequestAlbumUpdate) ToDTO() (*dto.AlbumUpdate, error) {
+	dto := &dto.AlbumUpdate{}
+	count := 0
+
+	errStr := ""
+
+	if schema.Title != nil {
+		title := strings.TrimSpace(*schema.Title)
+		if title == "" {
+			errStr += "title cannot be empty;"
+		} else {
+			dto.Title.String = title
+			dto.Title.Valid = true
+			count++
+		}
+	}
+	if schema.ArtistUUID != nil {
+		uuid, ok := parseUUID(*schema.ArtistUUID)
+		if !ok {
+			errStr += "'artist_uuid' must be valid uuid;"
+		} else {
+			dto.ArtistUUID = uuid
+			count++
+		}
+	}
+	if schema.Type != nil {
+		if !validAlbumType(*schema.Type) {
+			errStr += "'type' must be one of: album, single, ep, compilation;"
+		} else {
+			dto.Type.String = *schema.Type
+			dto.Type.Valid = true
+			count++
+		}
+	}
+	if schema.ReleaseDate != nil {
+		t, err := time.Parse("2006-01-02", *schema.ReleaseDate)
+		if err != nil {
+			errStr += "invalid date format, example: 2006-09-25;"
+		} else {
+			dto.ReleaseDate.Time = t
+			dto.ReleaseDate.Valid = true
+			count++
+		}
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	} else if count == 0 {
+		return nil, errors.New("at least one argument is required")
+	}
+
+	return dto, nil
+}
+
+type RequestAlbumTrackAdd struct {
+	AudioUUID string `json:"audio_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Position  int    `json:"position,omitempty" example:"1"`
+}
+
+// ToDTO
+// validate track. Omitted position appends track to the end
+func (schema *RequestAlbumTrackAdd) ToDTO() (*dto.AlbumTrackCreate, error) {
+	errStr := ""
+
+	uuid, ok := parseUUID(schema.AudioUUID)
+	if !ok {
+		errStr += "'audio_uuid' must be valid uuid;"
+	}
+	if schema.Position < 0 {
+		errStr += "'position' cannot be negative;"
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return &dto.AlbumTrackCreate{
+		AudioUUID: uuid,
+		Position:  schema.Position,
+	}, nil
+}
+
+type RequestAlbumTracksReplace struct {
+	Tracks []string `json:"tracks" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+}
+
+// ToDTO
+// return audio uuids in track order, duplicates are rejected
+func (schema *RequestAlbumTracksReplace) ToDTO() ([]pgtype.UUID, error) {
+	uuids := make([]pgtype.UUID, 0, len(schema.Tracks))
+	seen := make(map[pgtype.UUID]struct{}, len(schema.Tracks))
+
+	for _, track := range schema.Tracks {
+		uuid, ok := parseUUID(track)
+		if !ok {
+			return nil, errors.New("'tracks' must contain valid uuids;")
+		}
+		if _, ok = seen[uuid]; ok {
+			return nil, errors.New("'tracks' cannot contain duplicates;")
+		}
+		seen[uuid] = struct{}{}
+		uuids = append(uuids, uuid)
+	}
+	return uuids, nil
+}
+
+type ResponseAlbumRead struct {
+	UUID        pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	ArtistUUID  pgtype.UUID        `json:"artist_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Title       string             `json:"title" example:"Abbey Road"`
+	Type        string             `json:"type" example:"album"`
+	ReleaseDate pgtype.Date        `json:"release_date" swaggertype:"string" example:"1969-09-26"`
+	Cover       *ResponseCover     `json:"cover,omitempty"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseAlbumRead) FromDTO(dto *dto.AlbumRead) {
+	schema.UUID = dto.UUID
+	schema.ArtistUUID = dto.ArtistUUID
+	schema.Title = dto.Title
+	schema.Type = dto.Type
+	schema.ReleaseDate = dto.ReleaseDate
+	schema.Cover = NewResponseCover(dto.CoverKey)
+	schema.CreatedAt = dto.CreatedAt
+	schema.UpdatedAt = dto.UpdatedAt
+}
+
+type ResponseAlbumTrackRead struct {
+	ResponseAudioRead
+	Position int `json:"position" example:"1"`
+}
+
+type ResponseAlbumReadFull struct {
+	ResponseAlbumRead
+	Tracks []ResponseAlbumTrackRead `json:"tracks"`
+}
+
+func (schema *ResponseAlbumReadFull) FromDTOFull(dto *dto.AlbumReadFull) {
+	tracks := make([]ResponseAlbumTrackRead, 0, len(dto.Tracks))
+	for i := 0; i < len(dto.Tracks); i++ {
+		track := ResponseAlbumTrackRead{Position: dto.Tracks[i].Position}
+		track.FromDTO(&dto.Tracks[i].AudioRead)
+		tracks = append(tracks, track)
+	}
+
+	schema.FromDTO(&dto.AlbumRead)
+	schema.Tracks = tracks
+}
+
+type ResponseAudioAlbum struct {
+	UUID        pgtype.UUID `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Title       string      `json:"title" example:"Abbey Road"`
+	Type        string      `json:"type" example:"album"`
+	ReleaseDate pgtype.Date `json:"release_date" swaggertype:"string" example:"1969-09-26"`
+	Position    int         `json:"position" example:"1"`
+}
+
+func (schema *ResponseAudioAlbum) FromDTO(dto *dto.AudioAlbum) {
+	schema.UUID = dto.UUID
+	schema.Title = dto.Title
+	schema.Type = dto.Type
+	schema.ReleaseDate = dto.ReleaseDate
+	schema.Position = dto.Position
+}
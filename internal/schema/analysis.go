@@ -0,0 +1,34 @@
+package schema
+
+import "eMobile/pkg/lyrics"
+
+type ResponseLyricAnalysis struct {
+	Language        string              `json:"language,omitempty" enums:"en,ru" example:"en"`
+	Verses          int                 `json:"verses" example:"6"`
+	Lines           int                 `json:"lines" example:"24"`
+	Words           int                 `json:"words" example:"180"`
+	UniqueWords     int                 `json:"unique_words" example:"63"`
+	UniqueRatio     float64             `json:"unique_ratio" example:"0.35"`
+	RepetitionScore float64             `json:"repetition_score" example:"0.42"`
+	TopWords        []ResponseWordCount `json:"top_words"`
+}
+
+type ResponseWordCount struct {
+	Word  string `json:"word" example:"never"`
+	Count int    `json:"count" example:"12"`
+}
+
+func (schema *ResponseLyricAnalysis) FromDTO(analysis *lyrics.Analysis) {
+	schema.Language = analysis.Language
+	schema.Verses = analysis.Verses
+	schema.Lines = analysis.Lines
+	schema.Words = analysis.Words
+	schema.UniqueWords = analysis.UniqueWords
+	schema.UniqueRatio = analysis.UniqueRatio
+	schema.RepetitionScore = analysis.RepetitionScore
+
+	schema.TopWords = make([]ResponseWordCount, 0, len(analysis.TopWords))
+	for _, w := range analysis.TopWords {
+		schema.TopWords = append(schema.TopWords, ResponseWordCount{Word: w.Word, Count: w.Count})
+	}
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+)
+
+type RequestArtistCreate struct {
+	Name        string `json:"name" example:"The Beatles"`
+	Country     string `json:"country" example:"GB"`
+	Description string `json:"description" example:"English rock band formed in Liverpool in 1960"`
+}
+
+func (schema *RequestArtistCreate) ToDTO() (*dto.ArtistCreate, error) {
+	name := strings.TrimSpace(schema.Name)
+	if name == "" {
+		return nil, errors.New("'name' is required and cannot be empty;")
+	}
+
+	return &dto.ArtistCreate{
+		Name:        name,
+		Country:     schema.Country,
+		Description: schema.Description,
+	}, nil
+}
+
+type RequestArtistUpdate struct {
+	Name        *string `json:"name" example:"The Beatles"`
+	Country     *string `json:"country" example:"GB"`
+	Description *string `json:"description" example:"English rock band formed in Liverpool in 1960"`
+}
+
+func (schema *RequestArtistUpdate) ToDTO() (*dto.ArtistUpdate, error) {
+	dto := &dto.ArtistUpdate{}
+	count := 0
+
+	if schema.Name != nil {
+		name := strings.TrimSpace(*schema.Name)
+		if name == "" {
+			return nil, errors.New("name cannot be empty;")
+		}
+		dto.Name.String = name
+		dto.Name.Valid = true
+		count++
+	}
+	if schema.Country != nil {
+		dto.Country.String = *schema.Country
+		dto.Country.Valid = true
+		count++
+	}
+	if schema.Description != nil {
+		dto.Description.String = *schema.Description
+		dto.Description.Valid = true
+		count++
+	}
+
+	if count == 0 {
+		return nil, errors.New("at least one argument is required")
+	}
+	return dto, nil
+}
+
+type ResponseArtistRead struct {
+	UUID        pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Name        string             `json:"name" example:"The Beatles"`
+	Country     string             `json:"country" example:"GB"`
+	Description string             `json:"description" example:"English rock band formed in Liverpool in 1960"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseArtistRead) FromDTO(dto *dto.ArtistRead) {
+	schema.UUID = dto.UUID
+	schema.Name = dto.Name
+	schema.Country = dto.Country
+	schema.Description = dto.Description
+	schema.CreatedAt = dto.CreatedAt
+	schema.UpdatedAt = dto.UpdatedAt
+}
+
+type RequestArtistAliasCreate struct {
+	Alias string `json:"alias" example:"Beatles"`
+}
+
+func (schema *RequestArtistAliasCreate) ToDTO() (*dto.ArtistAliasCreate, error) {
+	alias := strings.TrimSpace(schema.Alias)
+	if alias == "" {
+		return nil, errors.New("'alias' is required and cannot be empty;")
+	}
+
+	return &dto.ArtistAliasCreate{
+		Alias: alias,
+	}, nil
+}
+
+type ResponseArtistAliasRead struct {
+	UUID       pgtype.UUID        `json:"uuid" example:"1b0c6a3e-7f0a-4a39-9a57-8b3a0d3e7c11"`
+	ArtistUUID pgtype.UUID        `json:"artist_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Alias      string             `json:"alias" example:"Beatles"`
+	Normalized string             `json:"normalized" example:"beatles"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseArtistAliasRead) FromDTO(dto *dto.ArtistAliasRead) {
+	schema.UUID = dto.UUID
+	schema.ArtistUUID = dto.ArtistUUID
+	schema.Alias = dto.Alias
+	schema.Normalized = dto.Normalized
+	schema.CreatedAt = dto.CreatedAt
+}
+
+type RequestArtistMerge struct {
+	SourceUUID string `json:"source_uuid" example:"5e0a1c0e-2f4b-4a43-8d3f-0f4b7c2d9a10"`
+}
+
+func (schema *RequestArtistMerge) ToUUID() (pgtype.UUID, error) {
+	uuid, ok := parseUUID(schema.SourceUUID)
+	if !ok {
+		return uuid, errors.New("'source_uuid' must be valid uuid;")
+	}
+	return uuid, nil
+}
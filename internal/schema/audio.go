@@ -3,18 +3,40 @@ package schema
 import (
 	"database/sql"
 	"eMobile/internal/dto"
+	"eMobile/pkg/canon"
+	"eMobile/pkg/links"
+	"eMobile/pkg/trackmeta"
 	"errors"
 	"github.com/jackc/pgx/v5/pgtype"
 	"net/url"
-	"time"
+	"strconv"
+	"strings"
 )
 
 type RequestAudioCreate struct {
-	Group string `json:"group" example:"classic"`
-	Song  string `json:"song" example:"some song"`
+	Group       string   `json:"group" example:"classic"`
+	Song        string   `json:"song" example:"some song"`
+	ReleaseDate *string  `json:"release_date,omitempty" example:"2012-09-23"`
+	Link        *string  `json:"link,omitempty" example:"https://youtu.be/dQw4w9WgXcQ"`
+	Lyrics      *string  `json:"lyrics,omitempty" example:"Never gonna give you up\n\nnever gonna let you down"`
+	DurationMs  *int32   `json:"duration_ms,omitempty" example:"213000"`
+	ISRC        *string  `json:"isrc,omitempty" example:"GB-AYE-87-00123"`
+	BPM         *float64 `json:"bpm,omitempty" example:"113"`
+	Key         *string  `json:"key,omitempty" example:"Ab major"`
+	Explicit    *bool    `json:"explicit,omitempty" example:"false"`
+	Source      string   `json:"source,omitempty" enums:"info,manual" example:"manual"`
 }
 
+// ToDTO
+// validate create request. Fields omitted here are fetched from
+// the info service unless source is "manual"
 func (schema *RequestAudioCreate) ToDTO() (*dto.AudioCreate, error) {
+	audioDTO := &dto.AudioCreate{
+		Group:  schema.Group,
+		Song:   schema.Song,
+		Source: dto.SourceInfo,
+	}
+
 	errStr := ""
 	if schema.Group == "" {
 		errStr += "'group' is required and cannot be empty;"
@@ -22,23 +44,73 @@ func (schema *RequestAudioCreate) ToDTO() (*dto.AudioCreate, error) {
 	if schema.Song == "" {
 		errStr += "'song' is required and cannot be empty;"
 	}
+	if schema.ReleaseDate != nil {
+		date, precision, err := parseReleaseDate(*schema.ReleaseDate)
+		if err != nil {
+			errStr += "invalid date format, examples: 2006, 2006-09, 2006-09-25;"
+		} else {
+			audioDTO.ReleaseDate = date
+			audioDTO.ReleaseDatePrecision = precision
+		}
+	}
+	if schema.Link != nil {
+		if *schema.Link == "" {
+			errStr += "link cannot be empty;"
+		} else if link, err := links.Parse(*schema.Link); err != nil {
+			errStr += "'link' " + err.Error() + ";"
+		} else {
+			audioDTO.Link = sql.NullString{String: link.URL, Valid: true}
+		}
+	}
+	if schema.Lyrics != nil {
+		if *schema.Lyrics == "" {
+			errStr += "lyrics cannot be empty;"
+		} else {
+			audioDTO.LyricsRaw = sql.NullString{String: *schema.Lyrics, Valid: true}
+		}
+	}
+	tech, techErr := parseTechnical(schema.DurationMs, schema.ISRC, schema.BPM, schema.Key)
+	errStr += techErr
+	audioDTO.DurationMs = tech.DurationMs
+	audioDTO.ISRC = tech.ISRC
+	audioDTO.BPM = tech.BPM
+	audioDTO.Key = tech.Key
+	if schema.Explicit != nil {
+		audioDTO.Explicit = pgtype.Bool{Bool: *schema.Explicit, Valid: true}
+	}
+
+	switch schema.Source {
+	case "", dto.SourceInfo:
+	case dto.SourceManual:
+		audioDTO.Source = dto.SourceManual
+		if schema.ReleaseDate == nil {
+			errStr += "'release_date' is required for manual source;"
+		}
+		if schema.Link == nil {
+			errStr += "'link' is required for manual source;"
+		}
+	default:
+		errStr += "'source' must be one of: info, manual;"
+	}
 
 	if errStr != "" {
 		return nil, errors.New(errStr)
 	}
 
-	return &dto.AudioCreate{
-		Group: schema.Group,
-		Song:  schema.Song,
-	}, nil
+	return audioDTO, nil
 }
 
 type RequestAudioUpdate struct {
-	Group       *string `json:"group" example:"classic"`
-	Song        *string `json:"song" example:"some song"`
-	ReleaseDate *string `json:"release_date" example:"2012-09-23"`
-	Link        *string `json:"link" example:"https://youtu.be/dQw4w9WgXcQ"`
-	Lyrics      *string `json:"lyrics" example:"Never gonna give you up\n\nnever gonna let you down"`
+	Group       *string  `json:"group" example:"classic"`
+	Song        *string  `json:"song" example:"some song"`
+	ReleaseDate *string  `json:"release_date" example:"2012-09-23"`
+	Link        *string  `json:"link" example:"https://youtu.be/dQw4w9WgXcQ"`
+	Lyrics      *string  `json:"lyrics" example:"Never gonna give you up\n\nnever gonna let you down"`
+	DurationMs  *int32   `json:"duration_ms" example:"213000"`
+	ISRC        *string  `json:"isrc" example:"GB-AYE-87-00123"`
+	BPM         *float64 `json:"bpm" example:"113"`
+	Key         *string  `json:"key" example:"Ab major"`
+	Explicit    *bool    `json:"explicit" example:"false"`
 }
 
 func (schema *RequestAudioUpdate) ToDTO() (*dto.AudioUpdate, error) {
@@ -66,20 +138,22 @@ func (schema *RequestAudioUpdate) ToDTO() (*dto.AudioUpdate, error) {
 		}
 	}
 	if schema.ReleaseDate != nil {
-		t, err := time.Parse("2006-01-02", *schema.ReleaseDate)
+		date, precision, err := parseReleaseDate(*schema.ReleaseDate)
 		if err != nil {
-			errStr += "invalid date format, example: 2006-09-25;"
+			errStr += "invalid date format, examples: 2006, 2006-09, 2006-09-25;"
 		} else {
-			dto.ReleaseDate.Time = t
-			dto.ReleaseDate.Valid = true
+			dto.ReleaseDate = date
+			dto.ReleaseDatePrecision = precision
 			count++
 		}
 	}
 	if schema.Link != nil {
 		if *schema.Link == "" {
 			errStr += "link cannot be empty;"
+		} else if link, err := links.Parse(*schema.Link); err != nil {
+			errStr += "'link' " + err.Error() + ";"
 		} else {
-			dto.Link.String = *schema.Link
+			dto.Link.String = link.URL
 			dto.Link.Valid = true
 			count++
 		}
@@ -93,6 +167,21 @@ func (schema *RequestAudioUpdate) ToDTO() (*dto.AudioUpdate, error) {
 			count++
 		}
 	}
+	tech, techErr := parseTechnical(schema.DurationMs, schema.ISRC, schema.BPM, schema.Key)
+	errStr += techErr
+	dto.DurationMs = tech.DurationMs
+	dto.ISRC = tech.ISRC
+	dto.BPM = tech.BPM
+	dto.Key = tech.Key
+	for _, valid := range []bool{tech.DurationMs.Valid, tech.ISRC.Valid, tech.BPM.Valid, tech.Key.Valid} {
+		if valid {
+			count++
+		}
+	}
+	if schema.Explicit != nil {
+		dto.Explicit = pgtype.Bool{Bool: *schema.Explicit, Valid: true}
+		count++
+	}
 
 	if errStr != "" {
 		return nil, errors.New(errStr)
@@ -103,13 +192,77 @@ func (schema *RequestAudioUpdate) ToDTO() (*dto.AudioUpdate, error) {
 	return dto, nil
 }
 
+// maxDurationMs
+// 24 hours, longer audios are considered input error
+const maxDurationMs = 24 * 60 * 60 * 1000
+
+// technical
+// validated technical metadata of audio
+type technical struct {
+	DurationMs pgtype.Int4
+	ISRC       pgtype.Text
+	BPM        pgtype.Float8
+	Key        pgtype.Text
+}
+
+// parseTechnical
+// validate technical metadata shared by create and update requests,
+// omitted fields stay invalid. Return error string in request format
+func parseTechnical(durationMs *int32, isrc *string, bpm *float64, key *string) (technical, string) {
+	tech := technical{}
+	errStr := ""
+	if durationMs != nil {
+		if *durationMs <= 0 || *durationMs > maxDurationMs {
+			errStr += "'duration_ms' must be between 1 and 86400000;"
+		} else {
+			tech.DurationMs = pgtype.Int4{Int32: *durationMs, Valid: true}
+		}
+	}
+	if isrc != nil {
+		code, err := trackmeta.ISRC(*isrc)
+		if err != nil {
+			errStr += "'isrc' " + err.Error() + ";"
+		} else {
+			tech.ISRC = pgtype.Text{String: code, Valid: true}
+		}
+	}
+	if bpm != nil {
+		if *bpm <= 0 || *bpm >= 1000 {
+			errStr += "'bpm' must be greater than 0 and less than 1000;"
+		} else {
+			tech.BPM = pgtype.Float8{Float64: *bpm, Valid: true}
+		}
+	}
+	if key != nil {
+		k, err := trackmeta.Key(*key)
+		if err != nil {
+			errStr += "'key' " + err.Error() + ";"
+		} else {
+			tech.Key = pgtype.Text{String: k, Valid: true}
+		}
+	}
+	return tech, errStr
+}
+
 type RequestAudioFilter struct {
-	Group             string `json:"group" example:"classic"`
-	Song              string `json:"song" example:"some song"`
-	ReleaseDateAfter  string `json:"after" example:"2012-09-23"`
-	ReleaseDateBefore string `json:"before" example:"2025-09-23"`
-	Link              string `json:"link" example:"https://youtu.be/dQw4w9WgXcQ"`
-	Lyric             string `json:"lyric" example:"never gonna give"`
+	Group             string   `json:"group" example:"classic"`
+	Song              string   `json:"song" example:"some song"`
+	ReleaseDateAfter  string   `json:"after" example:"2012-09-23"`
+	ReleaseDateBefore string   `json:"before" example:"2025-09-23"`
+	Link              string   `json:"link" example:"https://youtu.be/dQw4w9WgXcQ"`
+	Lyric             string   `json:"lyric" example:"never gonna give"`
+	Credit            string   `json:"credit" example:"Paul McCartney"`
+	CreditRole        string   `json:"credit_role" example:"writer"`
+	Genre             []string `json:"genre" example:"post-punk"`
+	GenreMode         string   `json:"genre_mode" enums:"any,all" example:"any"`
+	Tag               []string `json:"tag" example:"summer hits"`
+	TagMode           string   `json:"tag_mode" enums:"any,all" example:"all"`
+	Platform          string   `json:"platform" enums:"youtube,spotify,apple,yandex,soundcloud,other" example:"youtube"`
+	DurationMin       string   `json:"duration_min" example:"180000"`
+	DurationMax       string   `json:"duration_max" example:"300000"`
+	ISRC              string   `json:"isrc" example:"GBAYE8700123"`
+	Explicit          string   `json:"explicit" example:"false"`
+	Sort              string   `json:"sort" enums:"play_count,-play_count,created_at,-created_at,release_date,-release_date" example:"-play_count"`
 }
 
 func (schema *RequestAudioFilter) ToDTO() (*dto.AudioFilter, error) {
@@ -132,20 +285,22 @@ func (schema *RequestAudioFilter) ToDTO() (*dto.AudioFilter, error) {
 		filterDTO.Song = sql.NullString{String: schema.Song, Valid: true}
 		empty = false
 	}
+	// periods are inclusive: after=2012 starts at 2012-01-01,
+	// before=2012 ends at 2012-12-31
 	if schema.ReleaseDateAfter != "" {
-		t, err := time.Parse("2006-01-02", schema.ReleaseDateAfter)
+		date, _, err := parseReleaseDate(schema.ReleaseDateAfter)
 		if err != nil {
-			return nil, errors.New("invalid after format, example: 2006-09-25")
+			return nil, errors.New("invalid after format, examples: 2006, 2006-09, 2006-09-25")
 		}
-		filterDTO.ReleaseDateAfter = pgtype.Date{Time: t, Valid: true}
+		filterDTO.ReleaseDateAfter = date
 		empty = false
 	}
 	if schema.ReleaseDateBefore != "" {
-		t, err := time.Parse("2006-01-02", schema.ReleaseDateBefore)
+		date, precision, err := parseReleaseDate(schema.ReleaseDateBefore)
 		if err != nil {
-			return nil, errors.New("invalid before format, example: 2006-09-25")
+			return nil, errors.New("invalid before format, examples: 2006, 2006-09, 2006-09-25")
 		}
-		filterDTO.ReleaseDateBefore = pgtype.Date{Time: t, Valid: true}
+		filterDTO.ReleaseDateBefore = releaseDateEnd(date, precision)
 		empty = false
 	}
 	if filterDTO.ReleaseDateAfter.Valid && filterDTO.ReleaseDateBefore.Valid {
@@ -155,13 +310,103 @@ func (schema *RequestAudioFilter) ToDTO() (*dto.AudioFilter, error) {
 		empty = false
 	}
 	if schema.Link != "" {
-		filterDTO.Link = sql.NullString{String: schema.Link, Valid: true}
+		link, err := links.Parse(schema.Link)
+		if err != nil {
+			return nil, errors.New("'link' " + err.Error())
+		}
+		filterDTO.Link = sql.NullString{String: link.URL, Valid: true}
 		empty = false
 	}
 	if schema.Lyric != "" {
 		filterDTO.Lyric = sql.NullString{String: schema.Lyric, Valid: true}
 		empty = false
 	}
+	if schema.Credit != "" {
+		filterDTO.Credit = sql.NullString{String: schema.Credit, Valid: true}
+		empty = false
+	}
+	if schema.CreditRole != "" {
+		if schema.Credit == "" {
+			return nil, errors.New("'credit_role' requires 'credit'")
+		}
+		if !validCreditRole(schema.CreditRole) {
+			return nil, errors.New("'credit_role' must be one of: performer, featured, writer, composer, lyricist, producer")
+		}
+		filterDTO.CreditRole = sql.NullString{String: schema.CreditRole, Valid: true}
+	}
+	if schema.Platform != "" {
+		if !links.IsPlatform(schema.Platform) {
+			return nil, errors.New("'platform' must be one of: " + strings.Join(links.Platforms(), ", "))
+		}
+		filterDTO.Platform = sql.NullString{String: schema.Platform, Valid: true}
+		empty = false
+	}
+	if schema.DurationMin != "" {
+		ms, err := strconv.Atoi(schema.DurationMin)
+		if err != nil || ms < 0 || ms > maxDurationMs {
+			return nil, errors.New("'duration_min' must be milliseconds between 0 and 86400000")
+		}
+		filterDTO.DurationMin = pgtype.Int4{Int32: int32(ms), Valid: true}
+		empty = false
+	}
+	if schema.DurationMax != "" {
+		ms, err := strconv.Atoi(schema.DurationMax)
+		if err != nil || ms < 0 || ms > maxDurationMs {
+			return nil, errors.New("'duration_max' must be milliseconds between 0 and 86400000")
+		}
+		filterDTO.DurationMax = pgtype.Int4{Int32: int32(ms), Valid: true}
+		empty = false
+	}
+	if filterDTO.DurationMin.Valid && filterDTO.DurationMax.Valid &&
+		filterDTO.DurationMin.Int32 > filterDTO.DurationMax.Int32 {
+		return nil, errors.New("'duration_min' cannot be greater than 'duration_max'")
+	}
+	if schema.ISRC != "" {
+		code, err := trackmeta.ISRC(schema.ISRC)
+		if err != nil {
+			return nil, errors.New("'isrc' " + err.Error())
+		}
+		filterDTO.ISRC = sql.NullString{String: code, Valid: true}
+		empty = false
+	}
+	if schema.Explicit != "" {
+		explicit, err := strconv.ParseBool(schema.Explicit)
+		if err != nil {
+			return nil, errors.New("'explicit' must be true or false")
+		}
+		filterDTO.Explicit = pgtype.Bool{Bool: explicit, Valid: true}
+		empty = false
+	}
+	if schema.Sort != "" {
+		key := strings.TrimPrefix(schema.Sort, "-")
+		switch key {
+		case dto.SortPlayCount, dto.SortCreatedAt, dto.SortReleaseDate:
+		default:
+			return nil, errors.New("'sort' must be one of: play_count, created_at, release_date, '-' prefix sorts descending")
+		}
+		filterDTO.Sort = sql.NullString{String: key, Valid: true}
+		filterDTO.SortDesc = strings.HasPrefix(schema.Sort, "-")
+		empty = false
+	}
+	for _, genre := range schema.Genre {
+		if slug := canon.Slug(genre); slug != "" {
+			filterDTO.Genres = append(filterDTO.Genres, slug)
+			empty = false
+		}
+	}
+	for _, tag := range schema.Tag {
+		if tag = canon.Tag(tag); tag != "" {
+			filterDTO.Tags = append(filterDTO.Tags, tag)
+			empty = false
+		}
+	}
+	var err error
+	if filterDTO.GenresMatchAll, err = parseMatchMode("genre_mode", schema.GenreMode); err != nil {
+		return nil, err
+	}
+	if filterDTO.TagsMatchAll, err = parseMatchMode("tag_mode", schema.TagMode); err != nil {
+		return nil, err
+	}
 	if empty {
 		return nil, nil
 	}
@@ -177,47 +422,98 @@ func (schema *RequestAudioFilter) ScanQuery(u *url.URL) {
 	schema.ReleaseDateBefore = q.Get("before")
 	schema.Link = q.Get("link")
 	schema.Lyric = q.Get("lyric")
+	schema.Credit = q.Get("credit")
+	schema.CreditRole = q.Get("credit_role")
+	schema.Genre = splitQueryList(q["genre"])
+	schema.GenreMode = q.Get("genre_mode")
+	schema.Tag = splitQueryList(q["tag"])
+	schema.TagMode = q.Get("tag_mode")
+	schema.Platform = q.Get("platform")
+	schema.DurationMin = q.Get("duration_min")
+	schema.DurationMax = q.Get("duration_max")
+	schema.ISRC = q.Get("isrc")
+	schema.Explicit = q.Get("explicit")
+	schema.Sort = q.Get("sort")
+}
+
+// splitQueryList
+// return values of repeated query param, each value may be comma separated
+func splitQueryList(values []string) []string {
+	var list []string
+	for _, v := range values {
+		list = append(list, strings.Split(v, ",")...)
+	}
+	return list
+}
+
+// parseMatchMode
+// return true for "all" mode, empty mode is "any"
+func parseMatchMode(param, mode string) (bool, error) {
+	switch mode {
+	case "", "any":
+		return false, nil
+	case "all":
+		return true, nil
+	}
+	return false, errors.New("'" + param + "' must be one of: any, all")
 }
 
 type ResponseAudioRead struct {
 	UUID        pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	ArtistUUID  pgtype.UUID        `json:"artist_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
 	Group       string             `json:"group" example:"classic"`
 	Song        string             `json:"song" example:"some song"`
-	ReleaseDate pgtype.Date        `json:"release_date" swaggertype:"string" example:"2012-09-23"`
+	ReleaseDate *string            `json:"release_date" example:"2012-09-23"`
 	Link        string             `json:"link" example:"https://youtu.be/dQw4w9WgXcQ"`
+	DurationMs  *int32             `json:"duration_ms,omitempty" example:"213000"`
+	ISRC        *string            `json:"isrc,omitempty" example:"GBAYE8700123"`
+	BPM         *float64           `json:"bpm,omitempty" example:"113"`
+	Key         *string            `json:"key,omitempty" example:"Ab major"`
+	Explicit    bool               `json:"explicit,omitempty" example:"false"`
+	Source      string             `json:"source,omitempty" example:"info"`
+	Cover       *ResponseCover     `json:"cover,omitempty"`
+	PlayCount   int64              `json:"play_count,omitempty" example:"42"`
+	IsFavorite  *bool              `json:"is_favorite,omitempty" example:"true"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
 }
 
 func (schema *ResponseAudioRead) FromDTO(dto *dto.AudioRead) {
 	schema.UUID = dto.UUID
+	schema.ArtistUUID = dto.ArtistUUID
 	schema.Group = dto.Group
 	schema.Song = dto.Song
-	schema.ReleaseDate = dto.ReleaseDate
+	schema.ReleaseDate = formatReleaseDate(dto.ReleaseDate, dto.ReleaseDatePrecision)
 	schema.Link = dto.Link
+	if dto.DurationMs.Valid {
+		schema.DurationMs = &dto.DurationMs.Int32
+	}
+	if dto.ISRC.Valid {
+		schema.ISRC = &dto.ISRC.String
+	}
+	if dto.BPM.Valid {
+		schema.BPM = &dto.BPM.Float64
+	}
+	if dto.Key.Valid {
+		schema.Key = &dto.Key.String
+	}
+	schema.Explicit = dto.Explicit
+	schema.Source = dto.Source
+	schema.Cover = NewResponseCover(dto.CoverKey)
+	schema.PlayCount = dto.PlayCount
 	schema.CreatedAt = dto.CreatedAt
 	schema.UpdatedAt = dto.UpdatedAt
 }
 
 type ResponseAudioReadFull struct {
-	UUID        pgtype.UUID         `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
-	Group       string              `json:"group" example:"classic"`
-	Song        string              `json:"song" example:"some song"`
-	ReleaseDate pgtype.Date         `json:"release_date" swaggertype:"string" example:"2012-09-23"`
-	Link        string              `json:"link" example:"https://youtu.be/dQw4w9WgXcQ"`
-	Lyrics      []ResponseLyricRead `json:"lyrics,omitempty"`
-	CreatedAt   pgtype.Timestamptz  `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
-	UpdatedAt   pgtype.Timestamptz  `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
-}
-
-func (schema *ResponseAudioReadFull) FromDTO(dto *dto.AudioRead) {
-	schema.UUID = dto.UUID
-	schema.Group = dto.Group
-	schema.Song = dto.Song
-	schema.ReleaseDate = dto.ReleaseDate
-	schema.Link = dto.Link
-	schema.CreatedAt = dto.CreatedAt
-	schema.UpdatedAt = dto.UpdatedAt
+	ResponseAudioRead
+	Lyrics         []ResponseLyricRead  `json:"lyrics,omitempty"`
+	ExplicitVerses []pgtype.UUID        `json:"explicit_verses,omitempty" swaggertype:"array,string" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Album          *ResponseAudioAlbum  `json:"album,omitempty"`
+	Credits        []ResponseCreditRead `json:"credits,omitempty"`
+	Genres         []ResponseGenreRead  `json:"genres,omitempty"`
+	Tags           []string             `json:"tags,omitempty"`
+	Links          []ResponseLinkRead   `json:"links,omitempty"`
 }
 
 func (schema *ResponseAudioReadFull) FromDTOFull(dto *dto.AudioReadFull) {
@@ -226,14 +522,31 @@ func (schema *ResponseAudioReadFull) FromDTOFull(dto *dto.AudioReadFull) {
 		lyric := ResponseLyricRead{}
 		lyric.FromDTO(&dto.Lyrics[i])
 		lyrics = append(lyrics, lyric)
+		if dto.Lyrics[i].Explicit {
+			schema.ExplicitVerses = append(schema.ExplicitVerses, dto.Lyrics[i].UUID)
+		}
 	}
 
-	schema.UUID = dto.UUID
-	schema.Group = dto.Group
-	schema.Song = dto.Song
-	schema.ReleaseDate = dto.ReleaseDate
-	schema.Link = dto.Link
+	schema.FromDTO(&dto.AudioRead)
 	schema.Lyrics = lyrics
-	schema.CreatedAt = dto.CreatedAt
-	schema.UpdatedAt = dto.UpdatedAt
+	if dto.Album != nil {
+		schema.Album = &ResponseAudioAlbum{}
+		schema.Album.FromDTO(dto.Album)
+	}
+	for i := 0; i < len(dto.Credits); i++ {
+		credit := ResponseCreditRead{}
+		credit.FromDTO(&dto.Credits[i])
+		schema.Credits = append(schema.Credits, credit)
+	}
+	for i := 0; i < len(dto.Genres); i++ {
+		genre := ResponseGenreRead{}
+		genre.FromDTO(&dto.Genres[i])
+		schema.Genres = append(schema.Genres, genre)
+	}
+	schema.Tags = dto.Tags
+	for i := 0; i < len(dto.Links); i++ {
+		link := ResponseLinkRead{}
+		link.FromDTO(&dto.Links[i])
+		schema.Links = append(schema.Links, link)
+	}
 }
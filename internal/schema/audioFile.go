@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ResponseAudioFile struct {
+	AudioUUID  pgtype.UUID        `json:"audio_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Format     string             `json:"format" enums:"mp3,flac,ogg" example:"mp3"`
+	Size       int64              `json:"size" example:"5242880"`
+	SHA256     string             `json:"sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	DurationMs *int32             `json:"duration_ms,omitempty" example:"213000"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseAudioFile) FromDTO(dto *dto.AudioFileRead) {
+	schema.AudioUUID = dto.AudioUUID
+	schema.Format = dto.Format
+	schema.Size = dto.Size
+	schema.SHA256 = dto.SHA256
+	if dto.DurationMs.Valid {
+		schema.DurationMs = &dto.DurationMs.Int32
+	}
+	schema.CreatedAt = dto.CreatedAt
+}
+
+// ResponseAudioTags
+// tags read from audio file
+type ResponseAudioTags struct {
+	Title       string `json:"title,omitempty" example:"Never Gonna Give You Up"`
+	Artist      string `json:"artist,omitempty" example:"Rick Astley"`
+	Album       string `json:"album,omitempty" example:"Whenever You Need Somebody"`
+	Year        int    `json:"year,omitempty" example:"1987"`
+	TrackNumber int    `json:"track_number,omitempty" example:"1"`
+	Lyrics      string `json:"lyrics,omitempty" example:"Never gonna give you up"`
+	DurationMs  int64  `json:"duration_ms,omitempty" example:"213000"`
+}
+
+// ResponseAudioSuggestion
+// tag values missing or different in audio. Audio fields have the
+// same names as in audio update request, so they can be sent as is
+type ResponseAudioSuggestion struct {
+	Group       *string `json:"group,omitempty" example:"Rick Astley"`
+	Song        *string `json:"song,omitempty" example:"Never Gonna Give You Up"`
+	ReleaseDate *string `json:"release_date,omitempty" example:"1987"`
+	Lyrics      *string `json:"lyrics,omitempty" example:"Never gonna give you up"`
+	DurationMs  *int32  `json:"duration_ms,omitempty" example:"213000"`
+	Album       *string `json:"album,omitempty" example:"Whenever You Need Somebody"`
+	TrackNumber *int32  `json:"track_number,omitempty" example:"1"`
+}
+
+type ResponseAudioFileUpload struct {
+	File      ResponseAudioFile       `json:"file"`
+	Tags      ResponseAudioTags       `json:"tags"`
+	Suggested ResponseAudioSuggestion `json:"suggested"`
+}
+
+func (schema *ResponseAudioFileUpload) FromDTO(dto *dto.AudioFileUpload) {
+	schema.File.FromDTO(&dto.File)
+
+	schema.Tags = ResponseAudioTags{
+		Title:       dto.Tags.Title,
+		Artist:      dto.Tags.Artist,
+		Album:       dto.Tags.Album,
+		Year:        dto.Tags.Year,
+		TrackNumber: dto.Tags.TrackNumber,
+		Lyrics:      dto.Tags.Lyrics,
+		DurationMs:  dto.Tags.Duration.Milliseconds(),
+	}
+
+	suggested := &dto.Suggested
+	if suggested.Group.Valid {
+		schema.Suggested.Group = &suggested.Group.String
+	}
+	if suggested.Song.Valid {
+		schema.Suggested.Song = &suggested.Song.String
+	}
+	schema.Suggested.ReleaseDate = formatReleaseDate(suggested.ReleaseDate, suggested.ReleaseDatePrecision)
+	if suggested.Lyrics.Valid {
+		schema.Suggested.Lyrics = &suggested.Lyrics.String
+	}
+	if suggested.DurationMs.Valid {
+		schema.Suggested.DurationMs = &suggested.DurationMs.Int32
+	}
+	if suggested.Album.Valid {
+		schema.Suggested.Album = &suggested.Album.String
+	}
+	if suggested.TrackNumber.Valid {
+		schema.Suggested.TrackNumber = &suggested.TrackNumber.Int32
+	}
+}
@@ -1,7 +1,76 @@
 package schema
 
-import "github.com/jackc/pgx/v5/pgtype"
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+	"time"
+)
 
 type ResponseUUID struct {
 	pgtype.UUID `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
 }
+
+// parseUUID
+// parse uuid in canonical text form, ok is false if invalid
+func parseUUID(s string) (uuid pgtype.UUID, ok bool) {
+	return uuid, uuid.Scan(s) == nil
+}
+
+// releaseDateLayouts
+// accepted release date layouts with their precision
+var releaseDateLayouts = []struct {
+	layout    string
+	precision string
+}{
+	{"2006-01-02", dto.PrecisionDay},
+	{"2006-01", dto.PrecisionMonth},
+	{"2006", dto.PrecisionYear},
+}
+
+// parseReleaseDate
+// parse date of year, month or day precision: 2012, 2012-09 or 2012-09-23.
+// Date of coarser precision is set to the first day of period
+func parseReleaseDate(s string) (pgtype.Date, string, error) {
+	s = strings.TrimSpace(s)
+	for _, l := range releaseDateLayouts {
+		if len(s) != len(l.layout) {
+			continue
+		}
+		t, err := time.Parse(l.layout, s)
+		if err == nil {
+			return pgtype.Date{Time: t, Valid: true}, l.precision, nil
+		}
+	}
+	return pgtype.Date{}, "", errors.New("unknown date format: " + s)
+}
+
+// releaseDateEnd
+// return the last day of period of given precision starting at date
+func releaseDateEnd(date pgtype.Date, precision string) pgtype.Date {
+	switch precision {
+	case dto.PrecisionYear:
+		date.Time = date.Time.AddDate(1, 0, -1)
+	case dto.PrecisionMonth:
+		date.Time = date.Time.AddDate(0, 1, -1)
+	}
+	return date
+}
+
+// formatReleaseDate
+// render date at its precision, nil if date is not set
+func formatReleaseDate(date pgtype.Date, precision string) *string {
+	if !date.Valid {
+		return nil
+	}
+	layout := "2006-01-02"
+	switch precision {
+	case dto.PrecisionYear:
+		layout = "2006"
+	case dto.PrecisionMonth:
+		layout = "2006-01"
+	}
+	s := date.Time.Format(layout)
+	return &s
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"net/url"
+)
+
+// Chart movements
+const (
+	MovementUp   = "up"
+	MovementDown = "down"
+	MovementSame = "same"
+	MovementNew  = "new"
+)
+
+type RequestChartFilter struct {
+	Period string `json:"period"`
+	By     string `json:"by"`
+}
+
+func (schema *RequestChartFilter) ScanQuery(u *url.URL) {
+	q := u.Query()
+
+	schema.Period = q.Get("period")
+	schema.By = q.Get("by")
+}
+
+// ToDTO
+// validate chart filter, return period and kind of chart.
+// Period defaults to week, kind to audio
+func (schema *RequestChartFilter) ToDTO() (string, string, error) {
+	period, kind := dto.ChartPeriodWeek, dto.ChartByAudio
+
+	errStr := ""
+	switch schema.Period {
+	case "":
+	case dto.ChartPeriodWeek, dto.ChartPeriodMonth, dto.ChartPeriodAll:
+		period = schema.Period
+	default:
+		errStr += "'period' must be one of: week, month, all;"
+	}
+	switch schema.By {
+	case "":
+	case dto.ChartByAudio, dto.ChartByGroup:
+		kind = schema.By
+	default:
+		errStr += "'by' must be one of: audio, group;"
+	}
+
+	if errStr != "" {
+		return "", "", errors.New(errStr)
+	}
+	return period, kind, nil
+}
+
+type ResponseChartEntryRead struct {
+	Position         int64               `json:"position" example:"1"`
+	PreviousPosition *int64              `json:"previous_position,omitempty" example:"3"`
+	Movement         string              `json:"movement" enums:"up,down,same,new" example:"up"`
+	Plays            int64               `json:"plays" example:"42"`
+	RefreshedAt      pgtype.Timestamptz  `json:"refreshed_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	Audio            *ResponseAudioRead  `json:"audio,omitempty"`
+	Artist           *ResponseArtistRead `json:"artist,omitempty"`
+}
+
+func (schema *ResponseChartEntryRead) FromDTO(dto *dto.ChartEntryRead) {
+	schema.Position = dto.Position
+	schema.Plays = dto.Plays
+	schema.RefreshedAt = dto.RefreshedAt
+
+	schema.Movement = MovementNew
+	if dto.PreviousPosition.Valid {
+		schema.PreviousPosition = &dto.PreviousPosition.Int64
+		switch {
+		case dto.Position < dto.PreviousPosition.Int64:
+			schema.Movement = MovementUp
+		case dto.Position > dto.PreviousPosition.Int64:
+			schema.Movement = MovementDown
+		default:
+			schema.Movement = MovementSame
+		}
+	}
+
+	if dto.Audio != nil {
+		schema.Audio = &ResponseAudioRead{}
+		schema.Audio.FromDTO(dto.Audio)
+	}
+	if dto.Artist != nil {
+		schema.Artist = &ResponseArtistRead{}
+		schema.Artist.FromDTO(dto.Artist)
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strconv"
+)
+
+// blobURL
+// return public url of blob, replaced by SetBlobURL on startup
+var blobURL = func(key string) string {
+	return "/blob/" + key
+}
+
+// SetBlobURL
+// set function building public urls of stored blobs
+func SetBlobURL(f func(key string) string) {
+	blobURL = f
+}
+
+type ResponseCover struct {
+	Original   string            `json:"original" example:"/blob/covers/audios/da6f6e2cef5d4276b0a15067e77278ca/m1x2k3/original.jpg"`
+	Thumbnails map[string]string `json:"thumbnails" example:"256:/blob/covers/audios/da6f6e2cef5d4276b0a15067e77278ca/m1x2k3/256.jpg"`
+}
+
+// NewResponseCover
+// return urls of cover and its thumbnails keyed by size, nil if no cover
+func NewResponseCover(coverKey pgtype.Text) *ResponseCover {
+	if !coverKey.Valid {
+		return nil
+	}
+	cover := &ResponseCover{
+		Original:   blobURL(coverKey.String),
+		Thumbnails: make(map[string]string, len(dto.CoverSizes)),
+	}
+	for _, size := range dto.CoverSizes {
+		cover.Thumbnails[strconv.Itoa(size)] = blobURL(dto.CoverThumbnailKey(coverKey.String, size))
+	}
+	return cover
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+)
+
+// validCreditRole
+// return true if role is one of dto credit roles
+func validCreditRole(role string) bool {
+	switch role {
+	case dto.RolePerformer, dto.RoleFeatured, dto.RoleWriter, dto.RoleComposer, dto.RoleLyricist, dto.RoleProducer:
+		return true
+	}
+	return false
+}
+
+type RequestCreditCreate struct {
+	Name string `json:"name" example:"Paul McCartney"`
+	Role string `json:"role" enums:"performer,featured,writer,composer,lyricist,producer" example:"writer"`
+}
+
+func (schema *RequestCreditCreate) ToDTO() (*dto.CreditCreate, error) {
+	creditDTO := &dto.CreditCreate{
+		Name: strings.TrimSpace(schema.Name),
+		Role: schema.Role,
+	}
+
+	errStr := ""
+	if creditDTO.Name == "" {
+		errStr += "'name' is required and cannot be empty;"
+	}
+	if !validCreditRole(creditDTO.Role) {
+		errStr += "'role' must be one of: performer, featured, writer, composer, lyricist, producer;"
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return creditDTO, nil
+}
+
+type ResponseCreditRead struct {
+	UUID       pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	PersonUUID pgtype.UUID        `json:"person_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Name       string             `json:"name" example:"Paul McCartney"`
+	Role       string             `json:"role" example:"writer"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseCreditRead) FromDTO(dto *dto.CreditRead) {
+	schema.UUID = dto.UUID
+	schema.PersonUUID = dto.PersonUUID
+	schema.Name = dto.Name
+	schema.Role = dto.Role
+	schema.CreatedAt = dto.CreatedAt
+}
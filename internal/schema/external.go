@@ -2,8 +2,10 @@ package schema
 
 import (
 	"eMobile/internal/dto"
+	"eMobile/pkg/lyrics"
 	"errors"
 	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
 	"time"
 )
 
@@ -18,25 +20,68 @@ type ResponseAudioInfo struct {
 	Link        string `json:"link"`
 }
 
+// infoDateLayouts
+// accepted info service date layouts with their precision, most specific first
+var infoDateLayouts = []struct {
+	layout    string
+	precision string
+}{
+	{time.RFC3339, dto.PrecisionDay},
+	{"2006-01-02T15:04:05", dto.PrecisionDay},
+	{"2006-01-02 15:04:05", dto.PrecisionDay},
+	{"2.1.2006", dto.PrecisionDay},
+	{"2006-1-2", dto.PrecisionDay},
+	{"2006.1.2", dto.PrecisionDay},
+	{"2006/1/2", dto.PrecisionDay},
+	{"1.2006", dto.PrecisionMonth},
+	{"2006-1", dto.PrecisionMonth},
+	{"2006.1", dto.PrecisionMonth},
+	{"2006/1", dto.PrecisionMonth},
+	{"2006", dto.PrecisionYear},
+}
+
+// parseInfoDate
+// parse date in one of infoDateLayouts and return it with precision.
+// Missing month and day are set to the first one
+func parseInfoDate(s string) (time.Time, string, error) {
+	s = strings.TrimSpace(s)
+	for _, l := range infoDateLayouts {
+		t, err := time.Parse(l.layout, s)
+		if err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), l.precision, nil
+		}
+	}
+	return time.Time{}, "", errors.New("unknown date format: " + s)
+}
+
+// ToDTO
+// convert info service response. Invalid or empty fields are
+// skipped with warning, error is returned only if no field is usable
 func (schema *ResponseAudioInfo) ToDTO() (*dto.AudioInfo, error) {
-	date, err := time.Parse("02.01.2006", schema.ReleaseDate)
-	if err != nil {
-		return nil, err
+	info := &dto.AudioInfo{}
+
+	if strings.TrimSpace(schema.ReleaseDate) == "" {
+		info.Warnings = append(info.Warnings, "got empty 'releaseDate' in response")
+	} else if date, precision, err := parseInfoDate(schema.ReleaseDate); err != nil {
+		info.Warnings = append(info.Warnings, "invalid 'releaseDate' in response: "+err.Error())
+	} else {
+		info.ReleaseDate = pgtype.Date{Time: date, Valid: true}
+		info.ReleaseDatePrecision = precision
+	}
+
+	info.Text = lyrics.Normalize(schema.Text)
+	if info.Text == "" {
+		info.Warnings = append(info.Warnings, "got empty 'text' in response")
 	}
 
-	if schema.Text == "" {
-		return nil, errors.New("got empty 'text' in response")
+	info.Link = strings.TrimSpace(schema.Link)
+	if info.Link == "" {
+		info.Warnings = append(info.Warnings, "got empty 'link' in response")
 	}
-	if schema.Link == "" {
-		return nil, errors.New("got empty 'link' in response")
+
+	if !info.ReleaseDate.Valid && info.Text == "" && info.Link == "" {
+		return nil, errors.New("got no usable fields in response: " + strings.Join(info.Warnings, "; "))
 	}
 
-	return &dto.AudioInfo{
-		ReleaseDate: pgtype.Date{
-			Time:  date,
-			Valid: true,
-		},
-		Text: schema.Text,
-		Link: schema.Link,
-	}, nil
+	return info, nil
 }
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestResponseAudioInfo_ToDTO(t *testing.T) {
+	date := func(y int, m time.Month, d int) pgtype.Date {
+		return pgtype.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+
+	testTable := []struct {
+		name        string
+		input       ResponseAudioInfo
+		expected    *dto.AudioInfo
+		expectedErr bool
+	}{
+		{
+			name:     "dotted",
+			input:    ResponseAudioInfo{ReleaseDate: "23.09.2023", Text: "text", Link: "link"},
+			expected: &dto.AudioInfo{ReleaseDate: date(2023, 9, 23), ReleaseDatePrecision: dto.PrecisionDay, Text: "text", Link: "link"},
+		},
+		{
+			name:     "iso",
+			input:    ResponseAudioInfo{ReleaseDate: "2023-09-23", Text: "text", Link: "link"},
+			expected: &dto.AudioInfo{ReleaseDate: date(2023, 9, 23), ReleaseDatePrecision: dto.PrecisionDay, Text: "text", Link: "link"},
+		},
+		{
+			name:     "iso_datetime",
+			input:    ResponseAudioInfo{ReleaseDate: "2023-09-23T10:00:00+05:00", Text: "text", Link: "link"},
+			expected: &dto.AudioInfo{ReleaseDate: date(2023, 9, 23), ReleaseDatePrecision: dto.PrecisionDay, Text: "text", Link: "link"},
+		},
+		{
+			name:     "year_month",
+			input:    ResponseAudioInfo{ReleaseDate: "2023-09", Text: "text", Link: "link"},
+			expected: &dto.AudioInfo{ReleaseDate: date(2023, 9, 1), ReleaseDatePrecision: dto.PrecisionMonth, Text: "text", Link: "link"},
+		},
+		{
+			name:     "year",
+			input:    ResponseAudioInfo{ReleaseDate: " 2023 ", Text: "text", Link: "link"},
+			expected: &dto.AudioInfo{ReleaseDate: date(2023, 1, 1), ReleaseDatePrecision: dto.PrecisionYear, Text: "text", Link: "link"},
+		},
+		{
+			name:     "normalized_text",
+			input:    ResponseAudioInfo{ReleaseDate: "23.09.2023", Text: "line1\r\n\r\nline2\r\n", Link: "link"},
+			expected: &dto.AudioInfo{ReleaseDate: date(2023, 9, 23), ReleaseDatePrecision: dto.PrecisionDay, Text: "line1\n\nline2", Link: "link"},
+		},
+		{
+			name:  "partial_with_warnings",
+			input: ResponseAudioInfo{ReleaseDate: "someday", Text: " \r\n ", Link: "link"},
+			expected: &dto.AudioInfo{Link: "link", Warnings: []string{
+				"invalid 'releaseDate' in response: unknown date format: someday",
+				"got empty 'text' in response",
+			}},
+		},
+		{
+			name:        "nothing_usable",
+			input:       ResponseAudioInfo{},
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			info, err := testCase.input.ToDTO()
+			if testCase.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, info)
+		})
+	}
+}
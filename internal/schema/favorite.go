@@ -0,0 +1,18 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ResponseFavoriteRead struct {
+	ResponseAudioRead
+	FavoritedAt pgtype.Timestamptz `json:"favorited_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseFavoriteRead) FromDTO(dto *dto.FavoriteRead) {
+	isFavorite := true
+	schema.ResponseAudioRead.FromDTO(&dto.AudioRead)
+	schema.IsFavorite = &isFavorite
+	schema.FavoritedAt = dto.FavoritedAt
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"eMobile/pkg/canon"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTagLength
+// max length of tag in runes
+const maxTagLength = 64
+
+type RequestGenreCreate struct {
+	Name       string `json:"name" example:"Post-Punk"`
+	ParentUUID string `json:"parent_uuid,omitempty" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+}
+
+// ToDTO
+// validate genre, slug is derived from name
+func (schema *RequestGenreCreate) ToDTO() (*dto.GenreCreate, error) {
+	genreDTO := &dto.GenreCreate{
+		Name: strings.TrimSpace(schema.Name),
+		Slug: canon.Slug(schema.Name),
+	}
+
+	errStr := ""
+	if genreDTO.Slug == "" {
+		errStr += "'name' is required and must contain letters or digits;"
+	}
+	if schema.ParentUUID != "" {
+		uuid, ok := parseUUID(schema.ParentUUID)
+		if !ok {
+			errStr += "'parent_uuid' must be valid uuid;"
+		}
+		genreDTO.ParentUUID = uuid
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return genreDTO, nil
+}
+
+type RequestAudioGenreAttach struct {
+	GenreUUID string `json:"genre_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+}
+
+func (schema *RequestAudioGenreAttach) ToUUID() (pgtype.UUID, error) {
+	uuid, ok := parseUUID(schema.GenreUUID)
+	if !ok {
+		return uuid, errors.New("'genre_uuid' must be valid uuid;")
+	}
+	return uuid, nil
+}
+
+type RequestAudioTagAttach struct {
+	Tag string `json:"tag" example:"summer hits"`
+}
+
+// ToTag
+// return canonical tag
+func (schema *RequestAudioTagAttach) ToTag() (string, error) {
+	tag := canon.Tag(schema.Tag)
+	if tag == "" {
+		return "", errors.New("'tag' is required and cannot be empty;")
+	}
+	if utf8.RuneCountInString(tag) > maxTagLength {
+		return "", errors.New("'tag' cannot be longer than 64 characters;")
+	}
+	return tag, nil
+}
+
+type ResponseGenreRead struct {
+	UUID       pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	ParentUUID pgtype.UUID        `json:"parent_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Name       string             `json:"name" example:"Post-Punk"`
+	Slug       string             `json:"slug" example:"post-punk"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseGenreRead) FromDTO(dto *dto.GenreRead) {
+	schema.UUID = dto.UUID
+	schema.ParentUUID = dto.ParentUUID
+	schema.Name = dto.Name
+	schema.Slug = dto.Slug
+	schema.CreatedAt = dto.CreatedAt
+	schema.UpdatedAt = dto.UpdatedAt
+}
+
+type ResponseTagRead struct {
+	UUID       pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Name       string             `json:"name" example:"summer hits"`
+	AudioCount int                `json:"audio_count" example:"12"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseTagRead) FromDTO(dto *dto.TagRead) {
+	schema.UUID = dto.UUID
+	schema.Name = dto.Name
+	schema.AudioCount = dto.AudioCount
+	schema.CreatedAt = dto.CreatedAt
+}
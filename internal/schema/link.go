@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"eMobile/pkg/links"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+)
+
+type RequestLinkCreate struct {
+	URL      string `json:"url" example:"https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC"`
+	Platform string `json:"platform,omitempty" enums:"youtube,spotify,apple,yandex,soundcloud,other" example:"spotify"`
+	Primary  bool   `json:"primary,omitempty" example:"false"`
+}
+
+// ToDTO
+// validate link and convert url to canonical form.
+// Omitted platform is detected from url
+func (schema *RequestLinkCreate) ToDTO() (*dto.LinkCreate, error) {
+	linkDTO := &dto.LinkCreate{
+		Platform:  schema.Platform,
+		IsPrimary: schema.Primary,
+	}
+
+	errStr := ""
+	link, err := links.Parse(schema.URL)
+	if err != nil {
+		errStr += "'url' " + err.Error() + ";"
+	}
+	linkDTO.URL = link.URL
+	linkDTO.ExternalID = link.ExternalID
+	if linkDTO.Platform == "" {
+		linkDTO.Platform = link.Platform
+	} else if !links.IsPlatform(linkDTO.Platform) {
+		errStr += "'platform' must be one of: " + strings.Join(links.Platforms(), ", ") + ";"
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return linkDTO, nil
+}
+
+type ResponseLinkRead struct {
+	UUID       pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Platform   string             `json:"platform" example:"youtube"`
+	URL        string             `json:"url" example:"https://www.youtube.com/watch?v=dQw4w9WgXcQ"`
+	ExternalID string             `json:"external_id,omitempty" example:"dQw4w9WgXcQ"`
+	IsPrimary  bool               `json:"is_primary" example:"true"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseLinkRead) FromDTO(dto *dto.LinkRead) {
+	schema.UUID = dto.UUID
+	schema.Platform = dto.Platform
+	schema.URL = dto.URL
+	schema.ExternalID = dto.ExternalID
+	schema.IsPrimary = dto.IsPrimary
+	schema.CreatedAt = dto.CreatedAt
+}
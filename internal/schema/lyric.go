@@ -22,6 +22,7 @@ type ResponseLyricRead struct {
 	AudioUUID pgtype.UUID        `json:"audio_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
 	Order     int                `json:"order" example:"0"`
 	Text      string             `json:"text" example:"Never gonna give you up"`
+	Explicit  bool               `json:"explicit,omitempty" example:"false"`
 	CreatedAt pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
 }
@@ -31,6 +32,7 @@ func (schema *ResponseLyricRead) FromDTO(dto *dto.LyricRead) {
 	schema.AudioUUID = dto.AudioUUID
 	schema.Order = dto.Order
 	schema.Text = dto.Text
+	schema.Explicit = dto.Explicit
 	schema.CreatedAt = dto.CreatedAt
 	schema.UpdatedAt = dto.UpdatedAt
 }
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// maxPlayClockSkew
+// played_at may be ahead of server clock by this much
+const maxPlayClockSkew = time.Minute
+
+// maxPlayAge
+// older plays are rejected, otherwise backdating plays further
+// apart than dedup window would inflate play counts
+const maxPlayAge = 24 * time.Hour
+
+type RequestPlayCreate struct {
+	PlayedAt   *string `json:"played_at,omitempty" example:"2024-10-05T12:57:19+05:00"`
+	DurationMs *int32  `json:"duration_ms,omitempty" example:"180000"`
+}
+
+// ToDTO
+// validate play, omitted played_at is set to now by service.
+// User is set by handler
+func (schema *RequestPlayCreate) ToDTO() (*dto.PlayCreate, error) {
+	playDTO := &dto.PlayCreate{}
+
+	errStr := ""
+	if schema.PlayedAt != nil {
+		t, err := time.Parse(time.RFC3339, *schema.PlayedAt)
+		if err != nil {
+			errStr += "'played_at' must be RFC 3339 time, example: 2024-10-05T12:57:19+05:00;"
+		} else if t.After(time.Now().Add(maxPlayClockSkew)) {
+			errStr += "'played_at' cannot be in the future;"
+		} else if t.Before(time.Now().Add(-maxPlayAge)) {
+			errStr += "'played_at' cannot be older than 24 hours;"
+		} else {
+			playDTO.PlayedAt = pgtype.Timestamptz{Time: t, Valid: true}
+		}
+	}
+	if schema.DurationMs != nil {
+		if *schema.DurationMs < 0 || *schema.DurationMs > maxDurationMs {
+			errStr += "'duration_ms' must be milliseconds between 0 and 86400000;"
+		} else {
+			playDTO.DurationMs = pgtype.Int4{Int32: *schema.DurationMs, Valid: true}
+		}
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return playDTO, nil
+}
+
+type ResponsePlayRead struct {
+	UUID       pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	AudioUUID  pgtype.UUID        `json:"audio_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	PlayedAt   pgtype.Timestamptz `json:"played_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	DurationMs *int32             `json:"duration_ms,omitempty" example:"180000"`
+}
+
+func (schema *ResponsePlayRead) FromDTO(dto *dto.PlayRead) {
+	schema.UUID = dto.UUID
+	schema.AudioUUID = dto.AudioUUID
+	schema.PlayedAt = dto.PlayedAt
+	if dto.DurationMs.Valid {
+		schema.DurationMs = &dto.DurationMs.Int32
+	}
+}
+
+type ResponsePlayHistoryRead struct {
+	ResponseAudioRead
+	PlayUUID       pgtype.UUID        `json:"play_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	PlayedAt       pgtype.Timestamptz `json:"played_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	PlayDurationMs *int32             `json:"play_duration_ms,omitempty" example:"180000"`
+}
+
+func (schema *ResponsePlayHistoryRead) FromDTO(dto *dto.PlayHistoryRead) {
+	schema.ResponseAudioRead.FromDTO(&dto.AudioRead)
+	schema.PlayUUID = dto.PlayUUID
+	schema.PlayedAt = dto.PlayedAt
+	if dto.PlayDurationMs.Valid {
+		schema.PlayDurationMs = &dto.PlayDurationMs.Int32
+	}
+}
@@ -0,0 +1,153 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+)
+
+type RequestPlaylistCreate struct {
+	Name        string `json:"name" example:"Road trip"`
+	Description string `json:"description,omitempty" example:"Songs for long drives"`
+}
+
+// ToDTO
+// validate create request, owner is set by handler
+func (schema *RequestPlaylistCreate) ToDTO() (*dto.PlaylistCreate, error) {
+	playlistDTO := &dto.PlaylistCreate{
+		Name:        strings.TrimSpace(schema.Name),
+		Description: strings.TrimSpace(schema.Description),
+	}
+
+	if playlistDTO.Name == "" {
+		return nil, errors.New("'name' is required and cannot be empty;")
+	}
+	return playlistDTO, nil
+}
+
+type RequestPlaylistUpdate struct {
+	Name        *string `json:"name" example:"Road trip"`
+	Description *string `json:"description" example:"Songs for long drives"`
+}
+
+// ToDTO
+// validate update request, empty description clears it
+func (schema *RequestPlaylistUpdate) ToDTO() (*dto.PlaylistUpdate, error) {
+	dto := &dto.PlaylistUpdate{}
+	count := 0
+
+	errStr := ""
+
+	if schema.Name != nil {
+		name := strings.TrimSpace(*schema.Name)
+		if name == "" {
+			errStr += "name cannot be empty;"
+		} else {
+			dto.Name.String = name
+			dto.Name.Valid = true
+			count++
+		}
+	}
+	if schema.Description != nil {
+		dto.Description.String = strings.TrimSpace(*schema.Description)
+		dto.Description.Valid = true
+		count++
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	} else if count == 0 {
+		return nil, errors.New("at least one argument is required")
+	}
+
+	return dto, nil
+}
+
+type RequestPlaylistEntryAdd struct {
+	AudioUUID string `json:"audio_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Position  int    `json:"position,omitempty" example:"1"`
+}
+
+// ToDTO
+// validate entry. Omitted position appends entry to the end
+func (schema *RequestPlaylistEntryAdd) ToDTO() (*dto.PlaylistEntryCreate, error) {
+	errStr := ""
+
+	uuid, ok := parseUUID(schema.AudioUUID)
+	if !ok {
+		errStr += "'audio_uuid' must be valid uuid;"
+	}
+	if schema.Position < 0 {
+		errStr += "'position' cannot be negative;"
+	}
+
+	if errStr != "" {
+		return nil, errors.New(errStr)
+	}
+	return &dto.PlaylistEntryCreate{
+		AudioUUID: uuid,
+		Position:  schema.Position,
+	}, nil
+}
+
+type RequestPlaylistEntryMove struct {
+	Position int `json:"position" example:"1"`
+}
+
+// ToDTO
+// return position, zero position moves entry to the end
+func (schema *RequestPlaylistEntryMove) ToDTO() (int, error) {
+	if schema.Position < 0 {
+		return 0, errors.New("'position' cannot be negative;")
+	}
+	return schema.Position, nil
+}
+
+type ResponsePlaylistRead struct {
+	UUID        pgtype.UUID        `json:"uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Owner       string             `json:"owner" example:"user-42"`
+	Name        string             `json:"name" example:"Road trip"`
+	Description string             `json:"description" example:"Songs for long drives"`
+	EntryCount  int                `json:"entry_count" example:"12"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponsePlaylistRead) FromDTO(dto *dto.PlaylistRead) {
+	schema.UUID = dto.UUID
+	schema.Owner = dto.Owner
+	schema.Name = dto.Name
+	schema.Description = dto.Description
+	schema.EntryCount = dto.EntryCount
+	schema.CreatedAt = dto.CreatedAt
+	schema.UpdatedAt = dto.UpdatedAt
+}
+
+type ResponsePlaylistEntryRead struct {
+	ResponseAudioRead
+	EntryUUID pgtype.UUID        `json:"entry_uuid" example:"da6f6e2c-ef5d-4276-b0a1-5067e77278ca"`
+	Position  int                `json:"position" example:"1"`
+	AddedAt   pgtype.Timestamptz `json:"added_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+type ResponsePlaylistReadFull struct {
+	ResponsePlaylistRead
+	Entries []ResponsePlaylistEntryRead `json:"entries"`
+}
+
+func (schema *ResponsePlaylistReadFull) FromDTOFull(dto *dto.PlaylistReadFull) {
+	entries := make([]ResponsePlaylistEntryRead, 0, len(dto.Entries))
+	for i := 0; i < len(dto.Entries); i++ {
+		entry := ResponsePlaylistEntryRead{
+			EntryUUID: dto.Entries[i].EntryUUID,
+			Position:  dto.Entries[i].Position,
+			AddedAt:   dto.Entries[i].AddedAt,
+		}
+		entry.FromDTO(&dto.Entries[i].AudioRead)
+		entries = append(entries, entry)
+	}
+
+	schema.FromDTO(&dto.PlaylistRead)
+	schema.Entries = entries
+}
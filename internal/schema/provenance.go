@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ResponseProvenanceRead struct {
+	Field     string             `json:"field" example:"release_date"`
+	Source    string             `json:"source" example:"info"`
+	Editor    string             `json:"editor,omitempty" example:"editor@example.com"`
+	FetchedAt pgtype.Timestamptz `json:"fetched_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at" swaggertype:"string" example:"2024-10-05T12:57:19.752+05:00"`
+}
+
+func (schema *ResponseProvenanceRead) FromDTO(dto *dto.ProvenanceRead) {
+	schema.Field = dto.Field
+	schema.Source = dto.Source
+	schema.Editor = dto.Editor
+	schema.FetchedAt = dto.FetchedAt
+	schema.UpdatedAt = dto.UpdatedAt
+}
@@ -0,0 +1,40 @@
+package schema
+
+import "eMobile/internal/dto"
+
+type ResponseAudioStats struct {
+	Audios             int64                    `json:"audios" example:"120"`
+	WithoutLyrics      int64                    `json:"without_lyrics" example:"12"`
+	WithoutLink        int64                    `json:"without_link" example:"3"`
+	WithoutReleaseDate int64                    `json:"without_release_date" example:"5"`
+	AvgVerses          float64                  `json:"avg_verses" example:"4.25"`
+	ByGroup            []ResponseGroupAudioStat `json:"by_group"`
+	ByYear             []ResponseYearAudioStat  `json:"by_year"`
+}
+
+type ResponseGroupAudioStat struct {
+	Group  string `json:"group" example:"The Beatles"`
+	Audios int64  `json:"audios" example:"14"`
+}
+
+type ResponseYearAudioStat struct {
+	Year   int32 `json:"year" example:"2006"`
+	Audios int64 `json:"audios" example:"9"`
+}
+
+func (schema *ResponseAudioStats) FromDTO(dto *dto.AudioStats) {
+	schema.Audios = dto.Audios
+	schema.WithoutLyrics = dto.WithoutLyrics
+	schema.WithoutLink = dto.WithoutLink
+	schema.WithoutReleaseDate = dto.WithoutReleaseDate
+	schema.AvgVerses = dto.AvgVerses
+
+	schema.ByGroup = make([]ResponseGroupAudioStat, 0, len(dto.ByGroup))
+	for _, g := range dto.ByGroup {
+		schema.ByGroup = append(schema.ByGroup, ResponseGroupAudioStat{Group: g.Group, Audios: g.Audios})
+	}
+	schema.ByYear = make([]ResponseYearAudioStat, 0, len(dto.ByYear))
+	for _, y := range dto.ByYear {
+		schema.ByYear = append(schema.ByYear, ResponseYearAudioStat{Year: y.Year, Audios: y.Audios})
+	}
+}
@@ -0,0 +1,135 @@
+package albumService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type AlbumService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewAlbumService(d *Deps) *AlbumService {
+	return &AlbumService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *AlbumService) Create(album *dto.AlbumCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuid, err := s.r.Album.Create(ctx, album)
+	if err != nil {
+		s.l.Error("Error on creating album: ", err)
+	}
+	return uuid, err
+}
+
+// Find
+// return album with ordered tracks
+func (s *AlbumService) Find(uuid pgtype.UUID) (*dto.AlbumReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	album, err := s.r.Album.FindByUUIDWithTracks(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding album by uuid: ", err)
+	}
+	return album, err
+}
+
+func (s *AlbumService) ListPag(pag crud.Pagination) ([]dto.AlbumRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	albums, err := s.r.Album.ListByPag(ctx, pag)
+	if err != nil {
+		s.l.Error("Error on list albums: ", err)
+	}
+	return albums, err
+}
+
+func (s *AlbumService) Update(uuid pgtype.UUID, album *dto.AlbumUpdate) (*dto.AlbumRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	readAlbum, err := s.r.Album.Update(ctx, uuid, album)
+	if err != nil {
+		s.l.Error("Error on update album: ", err)
+	}
+	return readAlbum, err
+}
+
+func (s *AlbumService) Delete(uuid pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Album.Delete(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on delete album: ", err)
+	}
+	return err
+}
+
+// AddTrack
+// add track to album and return album with updated tracks
+func (s *AlbumService) AddTrack(uuid pgtype.UUID, track *dto.AlbumTrackCreate) (*dto.AlbumReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Album.AddTrack(ctx, uuid, track)
+	if err != nil {
+		s.l.Error("Error on add album track: ", err)
+		return nil, err
+	}
+	return s.findWithTracks(ctx, uuid)
+}
+
+// RemoveTrack
+// remove track from album and return album with updated tracks
+func (s *AlbumService) RemoveTrack(uuid, audioUUID pgtype.UUID) (*dto.AlbumReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Album.RemoveTrack(ctx, uuid, audioUUID)
+	if err != nil {
+		s.l.Error("Error on remove album track: ", err)
+		return nil, err
+	}
+	return s.findWithTracks(ctx, uuid)
+}
+
+// ReplaceTracks
+// set album tracks in given order and return album with updated tracks
+func (s *AlbumService) ReplaceTracks(uuid pgtype.UUID, audioUUIDs []pgtype.UUID) (*dto.AlbumReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Album.ReplaceTracks(ctx, uuid, audioUUIDs)
+	if err != nil {
+		s.l.Error("Error on replace album tracks: ", err)
+		return nil, err
+	}
+	return s.findWithTracks(ctx, uuid)
+}
+
+func (s *AlbumService) findWithTracks(ctx context.Context, uuid pgtype.UUID) (*dto.AlbumReadFull, error) {
+	album, err := s.r.Album.FindByUUIDWithTracks(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding album by uuid: ", err)
+	}
+	return album, err
+}
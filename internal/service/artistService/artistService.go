@@ -0,0 +1,166 @@
+package artistService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// ErrMergeSelf
+// artist cannot be merged into itself
+var ErrMergeSelf = errors.New("cannot merge artist into itself")
+
+type ArtistService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewArtistService(d *Deps) *ArtistService {
+	return &ArtistService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *ArtistService) Create(artist *dto.ArtistCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuid, err := s.r.Artist.Create(ctx, artist)
+	if err != nil {
+		s.l.Error("Error on creating artist: ", err)
+	}
+	return uuid, err
+}
+
+func (s *ArtistService) Find(uuid pgtype.UUID) (*dto.ArtistRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artist, err := s.r.Artist.FindByUUID(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding artist by uuid: ", err)
+	}
+	return artist, err
+}
+
+func (s *ArtistService) ListPag(pag crud.Pagination) ([]dto.ArtistRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	artists, err := s.r.Artist.ListByPag(ctx, pag)
+	if err != nil {
+		s.l.Error("Error on list artists: ", err)
+	}
+	return artists, err
+}
+
+func (s *ArtistService) ListAudios(uuid pgtype.UUID, pag crud.Pagination) ([]dto.AudioRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	audios, err := s.r.Artist.ListAudios(ctx, uuid, pag)
+	if err != nil {
+		s.l.Error("Error on list artist audios: ", err)
+	}
+	return audios, err
+}
+
+func (s *ArtistService) Update(uuid pgtype.UUID, artist *dto.ArtistUpdate) (*dto.ArtistRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	readArtist, err := s.r.Artist.Update(ctx, uuid, artist)
+	if err != nil {
+		s.l.Error("Error on update artist: ", err)
+	}
+	return readArtist, err
+}
+
+func (s *ArtistService) Delete(uuid pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Artist.Delete(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on delete artist: ", err)
+	}
+	return err
+}
+
+// NormalizeNames
+// fill canonical names of artists created before aliases support
+func (s *ArtistService) NormalizeNames() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	count, err := s.r.Artist.FillNormalizedNames(ctx)
+	if err != nil {
+		s.l.Error("Error on normalize artist names: ", err)
+		return err
+	}
+	if count > 0 {
+		s.l.Infof("Normalized %d artist names", count)
+	}
+	return nil
+}
+
+func (s *ArtistService) ListAliases(uuid pgtype.UUID) ([]dto.ArtistAliasRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	aliases, err := s.r.Artist.ListAliases(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on list artist aliases: ", err)
+	}
+	return aliases, err
+}
+
+func (s *ArtistService) CreateAlias(uuid pgtype.UUID, alias *dto.ArtistAliasCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	aliasUUID, err := s.r.Artist.CreateAlias(ctx, uuid, alias)
+	if err != nil {
+		s.l.Error("Error on creating artist alias: ", err)
+	}
+	return aliasUUID, err
+}
+
+func (s *ArtistService) DeleteAlias(uuid, aliasUUID pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Artist.DeleteAlias(ctx, uuid, aliasUUID)
+	if err != nil {
+		s.l.Error("Error on delete artist alias: ", err)
+	}
+	return err
+}
+
+// Merge
+// merge source artist into target, re-pointing all source audios
+func (s *ArtistService) Merge(targetUUID, sourceUUID pgtype.UUID, editor string) (*dto.ArtistRead, error) {
+	if targetUUID == sourceUUID {
+		return nil, ErrMergeSelf
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	artist, err := s.r.Artist.Merge(ctx, targetUUID, sourceUUID, editor)
+	if err != nil {
+		s.l.Error("Error on merge artists: ", err)
+	}
+	return artist, err
+}
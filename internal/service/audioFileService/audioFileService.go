@@ -0,0 +1,263 @@
+package audioFileService
+
+import (
+	"context"
+	"crypto/sha256"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/audiotag"
+	"eMobile/pkg/blob"
+	"eMobile/pkg/logging"
+	"encoding/hex"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMissingTags
+// audio can not be created from file without artist and title
+var ErrMissingTags = errors.New("file has no artist and title tags")
+
+// uploadTimeout
+// time to receive and store uploaded file
+const uploadTimeout = 5 * time.Minute
+
+// maxDurationMs
+// longer durations do not fit audios.duration_ms and are dropped
+const maxDurationMs = 24 * 60 * 60 * 1000
+
+// AudioCreator
+// creates and deletes audio the same way as audio API
+type AudioCreator interface {
+	Create(audio *dto.AudioCreate) (pgtype.UUID, error)
+	Delete(uuid pgtype.UUID) error
+}
+
+type AudioFileService struct {
+	r     repo.Repository
+	l     logging.Logger
+	blob  blob.Store
+	audio AudioCreator
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+	Blob   blob.Store
+	Audio  AudioCreator
+}
+
+func NewAudioFileService(d *Deps) *AudioFileService {
+	return &AudioFileService{
+		r:     d.Repo,
+		l:     d.Logger,
+		blob:  d.Blob,
+		audio: d.Audio,
+	}
+}
+
+// Upload
+// store mp3, flac or ogg file as file of audio replacing previous one.
+// Return stored file, its tags and audio fields suggested by tags
+func (s *AudioFileService) Upload(audioUUID pgtype.UUID, body io.Reader) (*dto.AudioFileUpload, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+	defer cancel()
+
+	file, tags, err := s.store(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.attach(ctx, audioUUID, file, tags)
+}
+
+// CreateFromUpload
+// store file and create audio from its tags, file must have artist
+// and title tags. Audio is created with manual source and deleted
+// if file can not be attached to it
+func (s *AudioFileService) CreateFromUpload(body io.Reader, editor string) (pgtype.UUID, *dto.AudioFileUpload, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+	defer cancel()
+
+	file, tags, err := s.store(ctx, body)
+	if err != nil {
+		return pgtype.UUID{}, nil, err
+	}
+	if tags.Artist == "" || tags.Title == "" {
+		s.removeBlob(ctx, file.BlobKey)
+		return pgtype.UUID{}, nil, ErrMissingTags
+	}
+
+	audio := &dto.AudioCreate{
+		Group:      tags.Artist,
+		Song:       tags.Title,
+		DurationMs: file.DurationMs,
+		Source:     dto.SourceManual,
+		Editor:     editor,
+	}
+	if tags.Year > 0 {
+		audio.ReleaseDate = pgtype.Date{Time: time.Date(tags.Year, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+		audio.ReleaseDatePrecision = dto.PrecisionYear
+	}
+	if tags.Lyrics != "" {
+		audio.LyricsRaw.String, audio.LyricsRaw.Valid = tags.Lyrics, true
+	}
+
+	uuid, err := s.audio.Create(audio)
+	if err != nil {
+		s.removeBlob(ctx, file.BlobKey)
+		return pgtype.UUID{}, nil, err
+	}
+
+	upload, err := s.attach(ctx, uuid, file, tags)
+	if err != nil {
+		// audio without file is removed, so retry does not create duplicate
+		if delErr := s.audio.Delete(uuid); delErr != nil {
+			s.l.Error("Error on deleting audio created from upload: ", delErr)
+		}
+		s.removeBlob(ctx, file.BlobKey)
+		return pgtype.UUID{}, nil, err
+	}
+	return uuid, upload, nil
+}
+
+// Open
+// return file of audio and its content, caller closes content.
+// sql.ErrNoRows if audio has no file
+func (s *AudioFileService) Open(audioUUID pgtype.UUID) (*dto.AudioFileRead, io.ReadSeekCloser, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	file, err := s.r.AudioFile.FindByAudio(ctx, audioUUID)
+	if err != nil {
+		s.l.Error("Error on finding audio file: ", err)
+		return nil, nil, err
+	}
+
+	content, _, err := s.blob.Open(ctx, file.BlobKey)
+	if err != nil {
+		s.l.Errorf("Error on opening audio file blob %q: %s", file.BlobKey, err)
+		return nil, nil, err
+	}
+	return file, content, nil
+}
+
+// store
+// write body to blob store and read its tags, blob is removed
+// if file is not supported audio
+func (s *AudioFileService) store(ctx context.Context, body io.Reader) (*dto.AudioFileCreate, *audiotag.Tags, error) {
+	key := path.Join("audio-files", strconv.FormatInt(time.Now().UnixNano(), 36))
+	hash := sha256.New()
+	size, err := s.blob.Put(ctx, key, io.TeeReader(body, hash))
+	if err != nil {
+		s.l.Error("Error on storing audio file: ", err)
+		return nil, nil, err
+	}
+
+	f, _, err := s.blob.Open(ctx, key)
+	if err != nil {
+		s.l.Error("Error on opening audio file: ", err)
+		s.removeBlob(ctx, key)
+		return nil, nil, err
+	}
+	tags, err := audiotag.Read(f)
+	f.Close()
+	if err != nil {
+		s.removeBlob(ctx, key)
+		return nil, nil, err
+	}
+
+	file := &dto.AudioFileCreate{
+		BlobKey: key,
+		Format:  tags.Format,
+		Size:    size,
+		SHA256:  hex.EncodeToString(hash.Sum(nil)),
+	}
+	if ms := tags.Duration.Milliseconds(); ms > 0 && ms <= maxDurationMs {
+		file.DurationMs = pgtype.Int4{Int32: int32(ms), Valid: true}
+	}
+	return file, tags, nil
+}
+
+// attach
+// save stored file as file of audio, replaced file is removed
+func (s *AudioFileService) attach(ctx context.Context, audioUUID pgtype.UUID, file *dto.AudioFileCreate, tags *audiotag.Tags) (*dto.AudioFileUpload, error) {
+	stored, old, err := s.r.AudioFile.Upsert(ctx, audioUUID, file)
+	if err != nil {
+		s.l.Error("Error on saving audio file: ", err)
+		s.removeBlob(ctx, file.BlobKey)
+		return nil, err
+	}
+	if old.Valid && old.String != file.BlobKey {
+		s.removeBlob(ctx, old.String)
+	}
+
+	audio, err := s.r.Audio.FindByUUIDWithLyrics(ctx, audioUUID)
+	if err != nil {
+		s.l.Error("Error on finding audio with lyrics by uuid: ", err)
+		return nil, err
+	}
+
+	return &dto.AudioFileUpload{
+		File:      *stored,
+		Tags:      *tags,
+		Suggested: suggest(audio, tags, stored.DurationMs),
+	}, nil
+}
+
+// suggest
+// return tag values missing or different in audio
+func suggest(audio *dto.AudioReadFull, tags *audiotag.Tags, durationMs pgtype.Int4) dto.AudioSuggestion {
+	differs := func(current, tag string) bool {
+		return tag != "" && !strings.EqualFold(strings.TrimSpace(current), tag)
+	}
+
+	suggestion := dto.AudioSuggestion{}
+	if differs(audio.Group, tags.Artist) {
+		suggestion.Group = pgtype.Text{String: tags.Artist, Valid: true}
+	}
+	if differs(audio.Song, tags.Title) {
+		suggestion.Song = pgtype.Text{String: tags.Title, Valid: true}
+	}
+	if tags.Year > 0 && (!audio.ReleaseDate.Valid || audio.ReleaseDate.Time.Year() != tags.Year) {
+		suggestion.ReleaseDate = pgtype.Date{Time: time.Date(tags.Year, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+		suggestion.ReleaseDatePrecision = dto.PrecisionYear
+	}
+	if tags.Lyrics != "" && len(audio.Lyrics) == 0 {
+		suggestion.Lyrics = pgtype.Text{String: tags.Lyrics, Valid: true}
+	}
+	// durations within a second are treated as equal
+	if durationMs.Valid && (!audio.DurationMs.Valid || abs(audio.DurationMs.Int32-durationMs.Int32) > 1000) {
+		suggestion.DurationMs = durationMs
+	}
+
+	albumTitle, position := "", 0
+	if audio.Album != nil {
+		albumTitle, position = audio.Album.Title, audio.Album.Position
+	}
+	if differs(albumTitle, tags.Album) {
+		suggestion.Album = pgtype.Text{String: tags.Album, Valid: true}
+	}
+	if tags.TrackNumber > 0 && tags.TrackNumber != position {
+		suggestion.TrackNumber = pgtype.Int4{Int32: int32(tags.TrackNumber), Valid: true}
+	}
+	return suggestion
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// removeBlob
+// delete blob, errors are only logged as orphaned blobs do not break anything
+func (s *AudioFileService) removeBlob(ctx context.Context, key string) {
+	if err := s.blob.Delete(ctx, key); err != nil {
+		s.l.Warnf("Error on delete audio file blob %q: %s", key, err)
+	}
+}
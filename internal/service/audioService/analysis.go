@@ -0,0 +1,32 @@
+package audioService
+
+import (
+	"context"
+	"eMobile/pkg/lyrics"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// analysisTopWords
+// count of most frequent words in lyric analysis
+const analysisTopWords = 10
+
+// Analyze
+// return text statistics of audio lyrics
+func (s *AudioService) Analyze(uuid pgtype.UUID) (*lyrics.Analysis, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	audio, err := s.r.Audio.FindByUUIDWithLyrics(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding audio with lyrics by uuid: ", err)
+		return nil, err
+	}
+
+	verses := make([]string, 0, len(audio.Lyrics))
+	for i := 0; i < len(audio.Lyrics); i++ {
+		verses = append(verses, audio.Lyrics[i].Text)
+	}
+	analysis := lyrics.Analyze(verses, analysisTopWords)
+	return &analysis, nil
+}
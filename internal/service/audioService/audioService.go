@@ -2,62 +2,147 @@ package audioService
 
 import (
 	"context"
+	"database/sql"
 	"eMobile/internal/crud"
 	"eMobile/internal/dto"
 	"eMobile/internal/repo"
 	"eMobile/internal/schema"
+	"eMobile/pkg/blob"
+	"eMobile/pkg/links"
 	"eMobile/pkg/logging"
+	"eMobile/pkg/lyrics"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5/pgtype"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 )
 
+// ErrAudioInfoNotFound
+// info service has no data for requested group and song
+var ErrAudioInfoNotFound = errors.New("audio info not found")
+
 type AudioService struct {
-	r       repo.Repository
-	http    *http.Client
-	l       logging.Logger
-	infoURL string
+	r          repo.Repository
+	http       *http.Client
+	l          logging.Logger
+	infoURL    string
+	infoCache  InfoCache
+	classifier Classifier
+	blob       blob.Store
 }
 
 type Deps struct {
-	Repo    repo.Repository
-	Logger  logging.Logger
-	Http    *http.Client
-	InfoURL string
+	Repo       repo.Repository
+	Logger     logging.Logger
+	Http       *http.Client
+	InfoURL    string
+	InfoCache  InfoCache  // optional
+	Classifier Classifier // optional
+	Blob       blob.Store // optional
 }
 
 func NewAudioService(d *Deps) *AudioService {
 	return &AudioService{
-		r:       d.Repo,
-		l:       d.Logger,
-		http:    d.Http,
-		infoURL: d.InfoURL,
+		r:          d.Repo,
+		l:          d.Logger,
+		http:       d.Http,
+		infoURL:    d.InfoURL,
+		infoCache:  d.InfoCache,
+		classifier: d.Classifier,
+		blob:       d.Blob,
 	}
 }
 
+// Create
+// create audio with lyrics. Fields not supplied in audio are
+// fetched from the info service, unless source is manual.
+// Explicit flag not supplied is detected from lyrics
 func (s *AudioService) Create(audio *dto.AudioCreate) (pgtype.UUID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	audioInfo, err := s.getAudioInfo(ctx, audio.Group, audio.Song)
-	if err != nil {
-		s.l.Logger.Error("Error getting audio info: ", err)
-		return pgtype.UUID{}, err
+	audioFull := &dto.AudioCreateFull{
+		Group:                audio.Group,
+		Song:                 audio.Song,
+		ReleaseDate:          audio.ReleaseDate,
+		ReleaseDatePrecision: audio.ReleaseDatePrecision,
+		Link:                 audio.Link.String,
+		DurationMs:           audio.DurationMs,
+		ISRC:                 audio.ISRC,
+		BPM:                  audio.BPM,
+		Key:                  audio.Key,
+		Source:               dto.SourceManual,
+	}
+	if audio.LyricsRaw.Valid {
+		audioFull.Lyrics = s.splitAudioText(audio.LyricsRaw.String)
 	}
 
-	lyrics := s.splitAudioText(audioInfo.Text)
+	manual := manualProvenance(audio.Editor)
+	audioFull.Provenance = []dto.ProvenanceCreate{
+		manual(dto.FieldGroup),
+		manual(dto.FieldSong),
+	}
 
-	audioFull := &dto.AudioCreateFull{
-		Group:       audio.Group,
-		Song:        audio.Song,
-		ReleaseDate: audioInfo.ReleaseDate,
-		Link:        audioInfo.Link,
-		Lyrics:      lyrics,
+	var info func(field string) dto.ProvenanceCreate
+	if audio.Source != dto.SourceManual && !audio.IsComplete() {
+		audioInfo, err := s.getAudioInfo(ctx, audio.Group, audio.Song)
+		if err != nil {
+			s.l.Logger.Error("Error getting audio info: ", err)
+			return pgtype.UUID{}, err
+		}
+
+		for _, warning := range audioInfo.Warnings {
+			s.l.Warnf("Audio info %q - %q: %s", audio.Group, audio.Song, warning)
+		}
+
+		audioFull.Source = dto.SourceInfo
+		info = infoProvenance(time.Now())
+		if !audio.ReleaseDate.Valid {
+			audioFull.ReleaseDate = audioInfo.ReleaseDate
+			audioFull.ReleaseDatePrecision = audioInfo.ReleaseDatePrecision
+		}
+		if !audio.Link.Valid {
+			audioFull.Link = s.canonicalInfoLink(audioInfo.Link)
+		}
+		if !audio.LyricsRaw.Valid {
+			audioFull.Lyrics = s.splitAudioText(audioInfo.Text)
+		}
+	}
+
+	for _, f := range []struct {
+		field    string
+		supplied bool
+	}{
+		{dto.FieldReleaseDate, audio.ReleaseDate.Valid},
+		{dto.FieldLink, audio.Link.Valid},
+		{dto.FieldLyrics, audio.LyricsRaw.Valid},
+	} {
+		if f.supplied || info == nil {
+			audioFull.Provenance = append(audioFull.Provenance, manual(f.field))
+		} else {
+			audioFull.Provenance = append(audioFull.Provenance, info(f.field))
+		}
+	}
+
+	explicit := s.classifyLyrics(audioFull.Lyrics)
+	if audio.Explicit.Valid {
+		audioFull.Explicit = audio.Explicit.Bool
+		audioFull.Provenance = append(audioFull.Provenance, manual(dto.FieldExplicit))
+	} else if explicit.Valid {
+		audioFull.Explicit = explicit.Bool
+		audioFull.Provenance = append(audioFull.Provenance, classifierProvenance())
+	}
+
+	artist, err := s.resolveArtist(ctx, audio.Group)
+	if err != nil {
+		s.l.Error("Error on resolving artist: ", err)
+		return pgtype.UUID{}, err
+	}
+	if artist != nil {
+		audioFull.Group = artist.Name
 	}
 
 	uuid, err := s.r.Audio.CreateWithLyrics(ctx, audioFull)
@@ -67,7 +152,49 @@ func (s *AudioService) Create(audio *dto.AudioCreate) (pgtype.UUID, error) {
 	return uuid, err
 }
 
+// resolveArtist
+// return artist matching group by canonical name or alias, nil if none
+func (s *AudioService) resolveArtist(ctx context.Context, group string) (*dto.ArtistRead, error) {
+	artist, err := s.r.Artist.FindByAlias(ctx, group)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return artist, err
+}
+
+// getAudioInfo
+// return audio info from cache if present, otherwise from info service.
+// Found and not found responses are cached
 func (s *AudioService) getAudioInfo(ctx context.Context, group, song string) (*dto.AudioInfo, error) {
+	if s.infoCache == nil {
+		return s.fetchAudioInfo(ctx, group, song)
+	}
+
+	key := infoCacheKey(group, song)
+	info, found, err := s.infoCache.Get(ctx, key)
+	if err != nil {
+		s.l.Warn("Error on reading info cache: ", err)
+	} else if found {
+		if info == nil {
+			return nil, ErrAudioInfoNotFound
+		}
+		return info, nil
+	}
+
+	info, err = s.fetchAudioInfo(ctx, group, song)
+	if err != nil && !errors.Is(err, ErrAudioInfoNotFound) {
+		return nil, err
+	}
+
+	if cacheErr := s.infoCache.Set(ctx, key, info); cacheErr != nil {
+		s.l.Warn("Error on writing info cache: ", cacheErr)
+	}
+	return info, err
+}
+
+// fetchAudioInfo
+// request audio info from info service
+func (s *AudioService) fetchAudioInfo(ctx context.Context, group, song string) (*dto.AudioInfo, error) {
 	queryGroup := url.QueryEscape(group)
 	querySong := url.QueryEscape(song)
 
@@ -87,6 +214,9 @@ func (s *AudioService) getAudioInfo(ctx context.Context, group, song string) (*d
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrAudioInfoNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, errors.New("got non 200 status: " + resp.Status) // todo 400 500 handle
 	}
@@ -105,16 +235,33 @@ func (s *AudioService) getAudioInfo(ctx context.Context, group, song string) (*d
 	return audioInfoDTO, nil
 }
 
+// splitAudioText
+// split text into ordered verses, blank verses are dropped
 func (s *AudioService) splitAudioText(text string) []dto.LyricCreate {
-	texts := strings.Split(text, "\n\n")
-	var lyrics []dto.LyricCreate
+	texts := lyrics.SplitVerses(text)
+	lyricsDTO := make([]dto.LyricCreate, 0, len(texts))
 	for i := 0; i < len(texts); i++ {
-		lyrics = append(lyrics, dto.LyricCreate{
+		lyricsDTO = append(lyricsDTO, dto.LyricCreate{
 			Order: i,
 			Text:  texts[i],
 		})
 	}
-	return lyrics
+	return lyricsDTO
+}
+
+// canonicalInfoLink
+// return canonical form of link from the info service,
+// empty if link is missing or invalid
+func (s *AudioService) canonicalInfoLink(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	link, err := links.Parse(rawURL)
+	if err != nil {
+		s.l.Warnf("Audio info link %q dropped: %s", rawURL, err)
+		return ""
+	}
+	return link.URL
 }
 
 func (s *AudioService) Find(uuid pgtype.UUID) (*dto.AudioRead, error) {
@@ -143,6 +290,17 @@ func (s *AudioService) ListByFilter(filter *dto.AudioFilter, pag crud.Pagination
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if filter.Group.Valid {
+		artist, err := s.resolveArtist(ctx, filter.Group.String)
+		if err != nil {
+			s.l.Error("Error on resolving artist: ", err)
+			return nil, err
+		}
+		if artist != nil {
+			filter.ArtistUUID = artist.UUID
+		}
+	}
+
 	audios, err := s.r.Audio.ListByFilter(ctx, filter, pag)
 	if err != nil {
 		s.l.Error("Error on list audios by filter: ", err)
@@ -161,23 +319,54 @@ func (s *AudioService) ListPag(pag crud.Pagination) ([]dto.AudioRead, error) {
 	return audios, err
 }
 
+// Update
+// update supplied fields of audio. Explicit flag is detected from
+// updated lyrics unless it is supplied or was set manually before
 func (s *AudioService) Update(uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.AudioRead, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	explicitSupplied := audio.Explicit.Valid
 	if audio.LyricsRaw.Valid {
-		lyrics := make([]dto.LyricCreate, 0)
-		lyricsTexts := strings.Split(audio.LyricsRaw.String, "\n\n")
-		for i := 0; i < len(lyricsTexts); i++ {
-			lyric := dto.LyricCreate{
-				AudioUUID: uuid,
-				Order:     i,
-				Text:      lyricsTexts[i],
-			}
-			lyrics = append(lyrics, lyric)
+		audio.Lyrics = s.splitAudioText(audio.LyricsRaw.String)
+	}
+	if explicit := s.classifyLyrics(audio.Lyrics); explicit.Valid && !explicitSupplied {
+		isManual, err := s.manualFields(ctx, uuid)
+		if err != nil {
+			s.l.Error("Error on list audio provenance: ", err)
+			return nil, err
 		}
+		if !isManual[dto.FieldExplicit] {
+			audio.Explicit = explicit
+			audio.Provenance = append(audio.Provenance, classifierProvenance())
+		}
+	}
+	if audio.Group.Valid {
+		artist, err := s.resolveArtist(ctx, audio.Group.String)
+		if err != nil {
+			s.l.Error("Error on resolving artist: ", err)
+			return nil, err
+		}
+		if artist != nil {
+			audio.Group.String = artist.Name
+		}
+	}
 
-		audio.Lyrics = lyrics
+	manual := manualProvenance(audio.Editor)
+	for _, f := range []struct {
+		field string
+		valid bool
+	}{
+		{dto.FieldGroup, audio.Group.Valid},
+		{dto.FieldSong, audio.Song.Valid},
+		{dto.FieldReleaseDate, audio.ReleaseDate.Valid},
+		{dto.FieldLink, audio.Link.Valid},
+		{dto.FieldLyrics, audio.LyricsRaw.Valid},
+		{dto.FieldExplicit, explicitSupplied},
+	} {
+		if f.valid {
+			audio.Provenance = append(audio.Provenance, manual(f.field))
+		}
 	}
 
 	readAudio, err := s.r.Audio.Update(ctx, uuid, audio)
@@ -187,13 +376,26 @@ func (s *AudioService) Update(uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.Au
 	return readAudio, err
 }
 
+// Delete
+// delete audio, blobs of its file and cover are removed after
+// audio is deleted
 func (s *AudioService) Delete(uuid pgtype.UUID) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	err := s.r.Audio.Delete(ctx, uuid)
+	keys, err := s.r.Audio.Delete(ctx, uuid)
 	if err != nil {
 		s.l.Error("Error on delete audio: ", err)
+		return err
+	}
+	for _, key := range keys {
+		if s.blob == nil {
+			s.l.Warnf("Blob %q of deleted audio is left in store", key)
+			continue
+		}
+		if err = s.blob.Delete(ctx, key); err != nil {
+			s.l.Warnf("Error on delete blob %q of deleted audio: %s", key, err)
+		}
 	}
-	return err
+	return nil
 }
@@ -0,0 +1,123 @@
+package audioService
+
+import (
+	"context"
+	"eMobile/mock/infoServer/fakeinfo"
+	"eMobile/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testFixture = fakeinfo.Fixture{
+	Group:       "classic",
+	Song:        "some song",
+	ReleaseDate: "23.09.2023",
+	Text:        "verse1\n\nverse2",
+	Link:        "https://youtu.be/dQw4w9WgXcQ",
+}
+
+// newFakeInfoServer
+// start fake info service with given fixtures, closed on test cleanup.
+// Info endpoint is available at srv.URL + "/info"
+func newFakeInfoServer(tb testing.TB, fixtures ...fakeinfo.Fixture) (*httptest.Server, *fakeinfo.Server) {
+	tb.Helper()
+	fake := fakeinfo.New(fixtures...)
+	srv := httptest.NewServer(fake)
+	tb.Cleanup(srv.Close)
+	return srv, fake
+}
+
+// scenarioTransport
+// set fake info service scenario header on each request
+type scenarioTransport struct {
+	scenario string
+}
+
+func (t scenarioTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set(fakeinfo.ScenarioHeader, t.scenario)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestAudioService_fetchAudioInfo(t *testing.T) {
+	testTable := []struct {
+		name        string
+		scenario    string
+		song        string
+		expectedErr string
+		notFound    bool
+	}{
+		{
+			name:     "ok",
+			scenario: "",
+			song:     testFixture.Song,
+		},
+		{
+			name:     "ok_with_latency",
+			scenario: "latency=10ms",
+			song:     testFixture.Song,
+		},
+		{
+			name:     "not_found_fixture",
+			scenario: "",
+			song:     "missing",
+			notFound: true,
+		},
+		{
+			name:        "fail",
+			scenario:    "fail",
+			song:        testFixture.Song,
+			expectedErr: "got non 200 status: 500 Internal Server Error",
+		},
+		{
+			name:        "bad_request",
+			scenario:    "bad-request",
+			song:        testFixture.Song,
+			expectedErr: "got non 200 status: 400 Bad Request",
+		},
+		{
+			name:        "malformed",
+			scenario:    "malformed",
+			song:        testFixture.Song,
+			expectedErr: "unexpected EOF",
+		},
+		{
+			name:        "timeout",
+			scenario:    "timeout",
+			song:        testFixture.Song,
+			expectedErr: "context deadline exceeded",
+		},
+	}
+
+	srv, fake := newFakeInfoServer(t, testFixture)
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := NewAudioService(&Deps{
+				Logger:  logging.GetLoggerTest(),
+				Http:    &http.Client{Transport: scenarioTransport{testCase.scenario}},
+				InfoURL: srv.URL + "/info",
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			info, err := s.fetchAudioInfo(ctx, testFixture.Group, testCase.song)
+			switch {
+			case testCase.notFound:
+				assert.ErrorIs(t, err, ErrAudioInfoNotFound)
+			case testCase.expectedErr != "":
+				assert.ErrorContains(t, err, testCase.expectedErr)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, testFixture.Link, info.Link)
+				assert.Equal(t, "verse1\n\nverse2", info.Text)
+			}
+
+			requests := fake.Requests()
+			assert.Equal(t, testCase.scenario, requests[len(requests)-1].Scenario)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package audioService
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// Classifier
+// detector of explicit text. Fingerprint changes whenever
+// classification of some text may change
+type Classifier interface {
+	IsExplicit(text string) bool
+	Fingerprint() string
+}
+
+// classifierProvenance
+// return provenance of explicit flag detected from lyrics
+func classifierProvenance() dto.ProvenanceCreate {
+	return dto.ProvenanceCreate{
+		Field:  dto.FieldExplicit,
+		Source: dto.SourceClassifier,
+	}
+}
+
+// classifyLyrics
+// mark explicit verses and return explicit flag of audio. Flag is
+// not valid if there is no classifier or verses
+func (s *AudioService) classifyLyrics(lyrics []dto.LyricCreate) pgtype.Bool {
+	if s.classifier == nil || len(lyrics) == 0 {
+		return pgtype.Bool{}
+	}
+
+	explicit := false
+	for i := 0; i < len(lyrics); i++ {
+		lyrics[i].Explicit = pgtype.Bool{Bool: s.classifier.IsExplicit(lyrics[i].Text), Valid: true}
+		explicit = explicit || lyrics[i].Explicit.Bool
+	}
+	return pgtype.Bool{Bool: explicit, Valid: true}
+}
+
+// classifyBatch
+// number of verses classified in one transaction
+const classifyBatch = 500
+
+// ClassifyLyrics
+// classify verses stored before classifier was enabled, batch by batch.
+// All verses are classified again if classifier changed since last run
+func (s *AudioService) ClassifyLyrics() error {
+	if s.classifier == nil {
+		return nil
+	}
+
+	if err := s.resetChangedClassifier(); err != nil {
+		s.l.Error("Error on reset lyrics classification: ", err)
+		return err
+	}
+
+	total := 0
+	for {
+		count, err := s.classifyLyricsBatch()
+		if err != nil {
+			s.l.Error("Error on classify lyrics: ", err)
+			return err
+		}
+		total += count
+		if count < classifyBatch {
+			break
+		}
+	}
+	if total > 0 {
+		s.l.Infof("Classified %d verses", total)
+	}
+	return nil
+}
+
+func (s *AudioService) classifyLyricsBatch() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return s.r.Lyric.FillExplicit(ctx, s.classifier.IsExplicit, classifyBatch)
+}
+
+// resetChangedClassifier
+// mark all verses not classified if they were classified with
+// different word lists
+func (s *AudioService) resetChangedClassifier() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	fingerprint, err := s.r.Lyric.ExplicitFingerprint(ctx)
+	if err != nil || fingerprint == s.classifier.Fingerprint() {
+		return err
+	}
+
+	s.l.Info("Profanity word lists changed, classifying all verses")
+	return s.r.Lyric.ResetExplicit(ctx, s.classifier.Fingerprint())
+}
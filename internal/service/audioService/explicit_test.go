@@ -0,0 +1,71 @@
+package audioService
+
+import (
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// wordClassifier
+// classify text containing word as explicit
+type wordClassifier string
+
+func (c wordClassifier) IsExplicit(text string) bool {
+	return strings.Contains(text, string(c))
+}
+
+func (c wordClassifier) Fingerprint() string {
+	return string(c)
+}
+
+func TestAudioService_classifyLyrics(t *testing.T) {
+	testTable := []struct {
+		name             string
+		classifier       Classifier
+		lyrics           []dto.LyricCreate
+		expected         pgtype.Bool
+		expectedExplicit []pgtype.Bool
+	}{
+		{
+			name:             "explicit_verse",
+			classifier:       wordClassifier("bad"),
+			lyrics:           []dto.LyricCreate{{Text: "good verse"}, {Text: "bad verse"}},
+			expected:         pgtype.Bool{Bool: true, Valid: true},
+			expectedExplicit: []pgtype.Bool{{Bool: false, Valid: true}, {Bool: true, Valid: true}},
+		},
+		{
+			name:             "clean",
+			classifier:       wordClassifier("bad"),
+			lyrics:           []dto.LyricCreate{{Text: "good verse"}},
+			expected:         pgtype.Bool{Bool: false, Valid: true},
+			expectedExplicit: []pgtype.Bool{{Bool: false, Valid: true}},
+		},
+		{
+			name:             "no_classifier",
+			lyrics:           []dto.LyricCreate{{Text: "bad verse"}},
+			expected:         pgtype.Bool{},
+			expectedExplicit: []pgtype.Bool{{}},
+		},
+		{
+			name:             "no_lyrics",
+			classifier:       wordClassifier("bad"),
+			expected:         pgtype.Bool{},
+			expectedExplicit: []pgtype.Bool{},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := NewAudioService(&Deps{Classifier: testCase.classifier})
+
+			assert.Equal(t, testCase.expected, s.classifyLyrics(testCase.lyrics))
+			explicit := make([]pgtype.Bool, 0, len(testCase.lyrics))
+			for _, lyric := range testCase.lyrics {
+				explicit = append(explicit, lyric.Explicit)
+			}
+			assert.Equal(t, testCase.expectedExplicit, explicit)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package audioService
+
+import (
+	"context"
+	"database/sql"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/cache"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+	"time"
+)
+
+// InfoCache
+// cache of info service responses. Get returns found=true and
+// nil info for cached 404 responses
+type InfoCache interface {
+	Get(ctx context.Context, key string) (info *dto.AudioInfo, found bool, err error)
+	Set(ctx context.Context, key string, info *dto.AudioInfo) error
+}
+
+// InfoCacheTTL
+// time to live of found and not found (negative) entries
+type InfoCacheTTL struct {
+	Found    time.Duration
+	NotFound time.Duration
+}
+
+func (t InfoCacheTTL) get(info *dto.AudioInfo) time.Duration {
+	if info == nil {
+		return t.NotFound
+	}
+	return t.Found
+}
+
+// infoCacheKey
+// return cache key from group and song, case and whitespace insensitive
+func infoCacheKey(group, song string) string {
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	}
+	return normalize(group) + "\n" + normalize(song)
+}
+
+type memoryInfoCache struct {
+	lru *cache.LRU[string, *dto.AudioInfo]
+	ttl InfoCacheTTL
+}
+
+// NewMemoryInfoCache
+// return in-process LRU info cache
+func NewMemoryInfoCache(size int, ttl InfoCacheTTL) InfoCache {
+	return &memoryInfoCache{
+		lru: cache.NewLRU[string, *dto.AudioInfo](size),
+		ttl: ttl,
+	}
+}
+
+func (c *memoryInfoCache) Get(_ context.Context, key string) (*dto.AudioInfo, bool, error) {
+	info, ok := c.lru.Get(key)
+	return info, ok, nil
+}
+
+func (c *memoryInfoCache) Set(_ context.Context, key string, info *dto.AudioInfo) error {
+	c.lru.Set(key, info, c.ttl.get(info))
+	return nil
+}
+
+type repoInfoCache struct {
+	r   repo.InfoCacheRepository
+	ttl InfoCacheTTL
+}
+
+// NewRepoInfoCache
+// return info cache stored in database, shared across replicas
+func NewRepoInfoCache(r repo.InfoCacheRepository, ttl InfoCacheTTL) InfoCache {
+	return &repoInfoCache{
+		r:   r,
+		ttl: ttl,
+	}
+}
+
+func (c *repoInfoCache) Get(ctx context.Context, key string) (*dto.AudioInfo, bool, error) {
+	entry, err := c.r.Find(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if entry.NotFound {
+		return nil, true, nil
+	}
+	return &dto.AudioInfo{
+		ReleaseDate:          entry.ReleaseDate,
+		ReleaseDatePrecision: entry.ReleaseDatePrecision,
+		Text:                 entry.Text,
+		Link:                 entry.Link,
+	}, true, nil
+}
+
+func (c *repoInfoCache) Set(ctx context.Context, key string, info *dto.AudioInfo) error {
+	entry := &dto.InfoCacheEntry{
+		Key:       key,
+		NotFound:  info == nil,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(c.ttl.get(info)), Valid: true},
+	}
+	if info != nil {
+		entry.ReleaseDate = info.ReleaseDate
+		entry.ReleaseDatePrecision = info.ReleaseDatePrecision
+		entry.Text = info.Text
+		entry.Link = info.Link
+	}
+	return c.r.Upsert(ctx, entry)
+}
@@ -0,0 +1,38 @@
+package audioService
+
+import (
+	"context"
+	"eMobile/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAudioService_getAudioInfoCached(t *testing.T) {
+	srv, fake := newFakeInfoServer(t, testFixture)
+
+	s := NewAudioService(&Deps{
+		Logger:    logging.GetLoggerTest(),
+		Http:      srv.Client(),
+		InfoURL:   srv.URL + "/info",
+		InfoCache: NewMemoryInfoCache(8, InfoCacheTTL{Found: time.Hour, NotFound: time.Hour}),
+	})
+	ctx := context.Background()
+
+	info, err := s.getAudioInfo(ctx, "Classic", "Some  song")
+	assert.NoError(t, err)
+	assert.Equal(t, testFixture.Link, info.Link)
+
+	// normalized key hits the cache
+	info, err = s.getAudioInfo(ctx, " classic", "some song ")
+	assert.NoError(t, err)
+	assert.Equal(t, testFixture.Link, info.Link)
+	assert.Len(t, fake.Requests(), 1)
+
+	// 404 is cached as well
+	_, err = s.getAudioInfo(ctx, "classic", "missing")
+	assert.ErrorIs(t, err, ErrAudioInfoNotFound)
+	_, err = s.getAudioInfo(ctx, "classic", "missing")
+	assert.ErrorIs(t, err, ErrAudioInfoNotFound)
+	assert.Len(t, fake.Requests(), 2)
+}
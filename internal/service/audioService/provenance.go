@@ -0,0 +1,120 @@
+package audioService
+
+import (
+	"context"
+	"database/sql"
+	"eMobile/internal/dto"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// manualProvenance
+// return constructor of provenance for fields set by editor
+func manualProvenance(editor string) func(field string) dto.ProvenanceCreate {
+	return func(field string) dto.ProvenanceCreate {
+		return dto.ProvenanceCreate{
+			Field:  field,
+			Source: dto.SourceManual,
+			Editor: editor,
+		}
+	}
+}
+
+// infoProvenance
+// return constructor of provenance for fields fetched from info service
+func infoProvenance(fetchedAt time.Time) func(field string) dto.ProvenanceCreate {
+	return func(field string) dto.ProvenanceCreate {
+		return dto.ProvenanceCreate{
+			Field:     field,
+			Source:    dto.SourceInfo,
+			FetchedAt: pgtype.Timestamptz{Time: fetchedAt, Valid: true},
+		}
+	}
+}
+
+// manualFields
+// return fields of audio edited manually
+func (s *AudioService) manualFields(ctx context.Context, uuid pgtype.UUID) (map[string]bool, error) {
+	provenance, err := s.r.Provenance.ListByAudio(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	manual := make(map[string]bool, len(provenance))
+	for i := 0; i < len(provenance); i++ {
+		manual[provenance[i].Field] = provenance[i].Source == dto.SourceManual
+	}
+	return manual, nil
+}
+
+// ListProvenance
+// return provenance of all tracked audio fields
+func (s *AudioService) ListProvenance(uuid pgtype.UUID) ([]dto.ProvenanceRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	provenance, err := s.r.Provenance.ListByAudio(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on list audio provenance: ", err)
+	}
+	return provenance, err
+}
+
+// Refresh
+// fetch audio info again and update fields which were not edited manually,
+// explicit flag is detected from fetched lyrics
+func (s *AudioService) Refresh(uuid pgtype.UUID) (*dto.AudioRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	audio, err := s.r.Audio.FindByUUID(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding audio by uuid: ", err)
+		return nil, err
+	}
+
+	manual, err := s.manualFields(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on list audio provenance: ", err)
+		return nil, err
+	}
+
+	audioInfo, err := s.getAudioInfo(ctx, audio.Group, audio.Song)
+	if err != nil {
+		s.l.Error("Error getting audio info: ", err)
+		return nil, err
+	}
+
+	for _, warning := range audioInfo.Warnings {
+		s.l.Warnf("Audio info %q - %q: %s", audio.Group, audio.Song, warning)
+	}
+
+	// fields missing in response are kept as is
+	info := infoProvenance(time.Now())
+	update := &dto.AudioUpdate{}
+	if !manual[dto.FieldReleaseDate] && audioInfo.ReleaseDate.Valid {
+		update.ReleaseDate = audioInfo.ReleaseDate
+		update.ReleaseDatePrecision = audioInfo.ReleaseDatePrecision
+		update.Provenance = append(update.Provenance, info(dto.FieldReleaseDate))
+	}
+	if link := s.canonicalInfoLink(audioInfo.Link); !manual[dto.FieldLink] && link != "" {
+		update.Link = sql.NullString{String: link, Valid: true}
+		update.Provenance = append(update.Provenance, info(dto.FieldLink))
+	}
+	if !manual[dto.FieldLyrics] && audioInfo.Text != "" {
+		update.Lyrics = s.splitAudioText(audioInfo.Text)
+		update.Provenance = append(update.Provenance, info(dto.FieldLyrics))
+		if explicit := s.classifyLyrics(update.Lyrics); explicit.Valid && !manual[dto.FieldExplicit] {
+			update.Explicit = explicit
+			update.Provenance = append(update.Provenance, classifierProvenance())
+		}
+	}
+	if len(update.Provenance) == 0 {
+		return audio, nil
+	}
+
+	readAudio, err := s.r.Audio.Update(ctx, uuid, update)
+	if err != nil {
+		s.l.Error("Error on refresh audio: ", err)
+	}
+	return readAudio, err
+}
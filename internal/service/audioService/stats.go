@@ -0,0 +1,32 @@
+package audioService
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"time"
+)
+
+// Stats
+// return aggregates of audios matched by filter, group
+// is resolved by artist aliases like in ListByFilter
+func (s *AudioService) Stats(filter *dto.AudioFilter) (*dto.AudioStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if filter.Group.Valid {
+		artist, err := s.resolveArtist(ctx, filter.Group.String)
+		if err != nil {
+			s.l.Error("Error on resolving artist: ", err)
+			return nil, err
+		}
+		if artist != nil {
+			filter.ArtistUUID = artist.UUID
+		}
+	}
+
+	stats, err := s.r.Audio.Stats(ctx, filter)
+	if err != nil {
+		s.l.Error("Error on audio stats: ", err)
+	}
+	return stats, err
+}
@@ -0,0 +1,53 @@
+package chartService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"time"
+)
+
+type ChartService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewChartService(d *Deps) *ChartService {
+	return &ChartService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+// List
+// return chart of kind for period as of last refresh
+func (s *ChartService) List(period, kind string, pag crud.Pagination) ([]dto.ChartEntryRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	entries, err := s.r.Chart.ListByPeriodPag(ctx, period, kind, pag)
+	if err != nil {
+		s.l.Error("Error on list chart: ", err)
+	}
+	return entries, err
+}
+
+// Refresh
+// recompute charts from plays
+func (s *ChartService) Refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := s.r.Chart.Refresh(ctx)
+	if err != nil {
+		s.l.Error("Error on refresh charts: ", err)
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package coverService
+
+import (
+	"bytes"
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/blob"
+	"eMobile/pkg/logging"
+	"eMobile/pkg/thumbnail"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"image"
+	"path"
+	"strconv"
+	"time"
+)
+
+type CoverService struct {
+	r    repo.Repository
+	l    logging.Logger
+	blob blob.Store
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+	Blob   blob.Store
+}
+
+func NewCoverService(d *Deps) *CoverService {
+	return &CoverService{
+		r:    d.Repo,
+		l:    d.Logger,
+		blob: d.Blob,
+	}
+}
+
+// Set
+// store jpeg or png image with its thumbnails as cover of audio or album
+// and return key of original image. Previous cover is removed.
+// Every upload gets new key, so cached cover urls never go stale
+func (s *CoverService) Set(target string, uuid pgtype.UUID, data []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	img, format, err := thumbnail.Decode(data)
+	if err != nil {
+		return "", err
+	}
+
+	ext := "jpg"
+	if format == thumbnail.FormatPNG {
+		ext = "png"
+	}
+	version := strconv.FormatInt(time.Now().UnixNano(), 36)
+	key := path.Join(dto.CoverKeyPrefix, target, fmt.Sprintf("%x", uuid.Bytes), version, "original."+ext)
+
+	err = s.putCover(ctx, key, data, img)
+	if err != nil {
+		s.l.Error("Error on storing cover: ", err)
+		s.removeCover(ctx, key)
+		return "", err
+	}
+
+	old, err := s.r.Cover.SetKey(ctx, target, uuid, pgtype.Text{String: key, Valid: true})
+	if err != nil {
+		s.l.Error("Error on set cover key: ", err)
+		s.removeCover(ctx, key)
+		return "", err
+	}
+	if old.Valid {
+		s.removeCover(ctx, old.String)
+	}
+	return key, nil
+}
+
+// Delete
+// remove cover of audio or album
+func (s *CoverService) Delete(target string, uuid pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	old, err := s.r.Cover.SetKey(ctx, target, uuid, pgtype.Text{})
+	if err != nil {
+		s.l.Error("Error on delete cover key: ", err)
+		return err
+	}
+	if old.Valid {
+		s.removeCover(ctx, old.String)
+	}
+	return nil
+}
+
+// putCover
+// write original image and its jpeg thumbnails
+func (s *CoverService) putCover(ctx context.Context, key string, data []byte, img image.Image) error {
+	_, err := s.blob.Put(ctx, key, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	// every thumbnail is resized from one full size copy
+	flat := thumbnail.Flatten(img)
+	buf := bytes.Buffer{}
+	for _, size := range dto.CoverSizes {
+		buf.Reset()
+		err = thumbnail.EncodeJPEG(&buf, thumbnail.Resize(flat, size))
+		if err != nil {
+			return err
+		}
+		_, err = s.blob.Put(ctx, dto.CoverThumbnailKey(key, size), &buf)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeCover
+// delete original image and thumbnails, errors are only logged
+// as orphaned blobs do not break anything
+func (s *CoverService) removeCover(ctx context.Context, key string) {
+	keys := []string{key}
+	for _, size := range dto.CoverSizes {
+		keys = append(keys, dto.CoverThumbnailKey(key, size))
+	}
+	for _, k := range keys {
+		if err := s.blob.Delete(ctx, k); err != nil {
+			s.l.Warnf("Error on delete cover blob %q: %s", k, err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package creditService
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type CreditService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewCreditService(d *Deps) *CreditService {
+	return &CreditService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *CreditService) ListByAudio(audioUUID pgtype.UUID) ([]dto.CreditRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	credits, err := s.r.Credit.ListByAudio(ctx, audioUUID)
+	if err != nil {
+		s.l.Error("Error on list audio credits: ", err)
+	}
+	return credits, err
+}
+
+func (s *CreditService) Create(audioUUID pgtype.UUID, credit *dto.CreditCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuid, err := s.r.Credit.Create(ctx, audioUUID, credit)
+	if err != nil {
+		s.l.Error("Error on creating audio credit: ", err)
+	}
+	return uuid, err
+}
+
+func (s *CreditService) Delete(audioUUID, creditUUID pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Credit.Delete(ctx, audioUUID, creditUUID)
+	if err != nil {
+		s.l.Error("Error on delete audio credit: ", err)
+	}
+	return err
+}
@@ -0,0 +1,80 @@
+package favoriteService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type FavoriteService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewFavoriteService(d *Deps) *FavoriteService {
+	return &FavoriteService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *FavoriteService) Add(userID string, audioUUID pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Favorite.Add(ctx, userID, audioUUID)
+	if err != nil {
+		s.l.Error("Error on add favorite: ", err)
+	}
+	return err
+}
+
+func (s *FavoriteService) Remove(userID string, audioUUID pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Favorite.Remove(ctx, userID, audioUUID)
+	if err != nil {
+		s.l.Error("Error on remove favorite: ", err)
+	}
+	return err
+}
+
+func (s *FavoriteService) ListPag(userID string, pag crud.Pagination) ([]dto.FavoriteRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	favorites, err := s.r.Favorite.ListByUserPag(ctx, userID, pag)
+	if err != nil {
+		s.l.Error("Error on list favorites: ", err)
+	}
+	return favorites, err
+}
+
+// Filter
+// return which of audios are favorites of user
+func (s *FavoriteService) Filter(userID string, audioUUIDs []pgtype.UUID) (map[pgtype.UUID]bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuids, err := s.r.Favorite.FilterAudios(ctx, userID, audioUUIDs)
+	if err != nil {
+		s.l.Error("Error on filter favorites: ", err)
+		return nil, err
+	}
+
+	favorites := make(map[pgtype.UUID]bool, len(uuids))
+	for _, uuid := range uuids {
+		favorites[uuid] = true
+	}
+	return favorites, nil
+}
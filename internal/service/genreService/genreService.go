@@ -0,0 +1,94 @@
+package genreService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type GenreService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewGenreService(d *Deps) *GenreService {
+	return &GenreService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *GenreService) Create(genre *dto.GenreCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuid, err := s.r.Genre.Create(ctx, genre)
+	if err != nil {
+		s.l.Error("Error on creating genre: ", err)
+	}
+	return uuid, err
+}
+
+func (s *GenreService) Find(uuid pgtype.UUID) (*dto.GenreRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	genre, err := s.r.Genre.FindByUUID(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding genre by uuid: ", err)
+	}
+	return genre, err
+}
+
+func (s *GenreService) ListPag(pag crud.Pagination) ([]dto.GenreRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	genres, err := s.r.Genre.ListByPag(ctx, pag)
+	if err != nil {
+		s.l.Error("Error on list genres: ", err)
+	}
+	return genres, err
+}
+
+func (s *GenreService) Delete(uuid pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Genre.Delete(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on delete genre: ", err)
+	}
+	return err
+}
+
+func (s *GenreService) Attach(audioUUID, genreUUID pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Genre.Attach(ctx, audioUUID, genreUUID)
+	if err != nil {
+		s.l.Error("Error on attach genre to audio: ", err)
+	}
+	return err
+}
+
+func (s *GenreService) Detach(audioUUID, genreUUID pgtype.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Genre.Detach(ctx, audioUUID, genreUUID)
+	if err != nil {
+		s.l.Error("Error on detach genre from audio: ", err)
+	}
+	return err
+}
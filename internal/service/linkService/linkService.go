@@ -0,0 +1,91 @@
+package linkService
+
+import (
+	"context"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type LinkService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewLinkService(d *Deps) *LinkService {
+	return &LinkService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *LinkService) ListByAudio(audioUUID pgtype.UUID) ([]dto.LinkRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	audioLinks, err := s.r.Link.ListByAudio(ctx, audioUUID)
+	if err != nil {
+		s.l.Error("Error on list audio links: ", err)
+	}
+	return audioLinks, err
+}
+
+func (s *LinkService) Create(audioUUID pgtype.UUID, link *dto.LinkCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuid, err := s.r.Link.Create(ctx, audioUUID, link)
+	if err != nil {
+		s.l.Error("Error on creating audio link: ", err)
+	}
+	return uuid, err
+}
+
+// SetPrimary
+// make link primary, audio link field is set to its url
+func (s *LinkService) SetPrimary(audioUUID, linkUUID pgtype.UUID, editor string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Link.SetPrimary(ctx, audioUUID, linkUUID, editor)
+	if err != nil {
+		s.l.Error("Error on set primary audio link: ", err)
+	}
+	return err
+}
+
+func (s *LinkService) Delete(audioUUID, linkUUID pgtype.UUID, editor string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Link.Delete(ctx, audioUUID, linkUUID, editor)
+	if err != nil {
+		s.l.Error("Error on delete audio link: ", err)
+	}
+	return err
+}
+
+// CanonicalizeURLs
+// create links of audios stored before links table and convert links
+// stored before url canonicalization to canonical form
+func (s *LinkService) CanonicalizeURLs() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	count, err := s.r.Link.CanonicalizeURLs(ctx)
+	if err != nil {
+		s.l.Error("Error on canonicalize link urls: ", err)
+		return err
+	}
+	if count > 0 {
+		s.l.Infof("Canonicalized %d link urls", count)
+	}
+	return nil
+}
@@ -12,6 +12,8 @@ package mock_service
 import (
 	crud "eMobile/internal/crud"
 	dto "eMobile/internal/dto"
+	lyrics "eMobile/pkg/lyrics"
+	io "io"
 	reflect "reflect"
 
 	pgtype "github.com/jackc/pgx/v5/pgtype"
@@ -41,6 +43,35 @@ func (m *MockIAudioService) EXPECT() *MockIAudioServiceMockRecorder {
 	return m.recorder
 }
 
+// Analyze mocks base method.
+func (m *MockIAudioService) Analyze(uuid pgtype.UUID) (*lyrics.Analysis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Analyze", uuid)
+	ret0, _ := ret[0].(*lyrics.Analysis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Analyze indicates an expected call of Analyze.
+func (mr *MockIAudioServiceMockRecorder) Analyze(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Analyze", reflect.TypeOf((*MockIAudioService)(nil).Analyze), uuid)
+}
+
+// ClassifyLyrics mocks base method.
+func (m *MockIAudioService) ClassifyLyrics() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClassifyLyrics")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClassifyLyrics indicates an expected call of ClassifyLyrics.
+func (mr *MockIAudioServiceMockRecorder) ClassifyLyrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClassifyLyrics", reflect.TypeOf((*MockIAudioService)(nil).ClassifyLyrics))
+}
+
 // Create mocks base method.
 func (m *MockIAudioService) Create(audio *dto.AudioCreate) (pgtype.UUID, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +161,51 @@ func (mr *MockIAudioServiceMockRecorder) ListPag(pag any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPag", reflect.TypeOf((*MockIAudioService)(nil).ListPag), pag)
 }
 
+// ListProvenance mocks base method.
+func (m *MockIAudioService) ListProvenance(uuid pgtype.UUID) ([]dto.ProvenanceRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProvenance", uuid)
+	ret0, _ := ret[0].([]dto.ProvenanceRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProvenance indicates an expected call of ListProvenance.
+func (mr *MockIAudioServiceMockRecorder) ListProvenance(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvenance", reflect.TypeOf((*MockIAudioService)(nil).ListProvenance), uuid)
+}
+
+// Refresh mocks base method.
+func (m *MockIAudioService) Refresh(uuid pgtype.UUID) (*dto.AudioRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", uuid)
+	ret0, _ := ret[0].(*dto.AudioRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockIAudioServiceMockRecorder) Refresh(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockIAudioService)(nil).Refresh), uuid)
+}
+
+// Stats mocks base method.
+func (m *MockIAudioService) Stats(filter *dto.AudioFilter) (*dto.AudioStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", filter)
+	ret0, _ := ret[0].(*dto.AudioStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockIAudioServiceMockRecorder) Stats(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockIAudioService)(nil).Stats), filter)
+}
+
 // Update mocks base method.
 func (m *MockIAudioService) Update(uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.AudioRead, error) {
 	m.ctrl.T.Helper()
@@ -182,3 +258,1119 @@ func (mr *MockILyricServiceMockRecorder) ListByAudioPag(uuid, pag any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAudioPag", reflect.TypeOf((*MockILyricService)(nil).ListByAudioPag), uuid, pag)
 }
+
+// MockIArtistService is a mock of IArtistService interface.
+type MockIArtistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIArtistServiceMockRecorder
+}
+
+// MockIArtistServiceMockRecorder is the mock recorder for MockIArtistService.
+type MockIArtistServiceMockRecorder struct {
+	mock *MockIArtistService
+}
+
+// NewMockIArtistService creates a new mock instance.
+func NewMockIArtistService(ctrl *gomock.Controller) *MockIArtistService {
+	mock := &MockIArtistService{ctrl: ctrl}
+	mock.recorder = &MockIArtistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIArtistService) EXPECT() *MockIArtistServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIArtistService) Create(artist *dto.ArtistCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", artist)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIArtistServiceMockRecorder) Create(artist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIArtistService)(nil).Create), artist)
+}
+
+// CreateAlias mocks base method.
+func (m *MockIArtistService) CreateAlias(uuid pgtype.UUID, alias *dto.ArtistAliasCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlias", uuid, alias)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlias indicates an expected call of CreateAlias.
+func (mr *MockIArtistServiceMockRecorder) CreateAlias(uuid, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlias", reflect.TypeOf((*MockIArtistService)(nil).CreateAlias), uuid, alias)
+}
+
+// Delete mocks base method.
+func (m *MockIArtistService) Delete(uuid pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIArtistServiceMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIArtistService)(nil).Delete), uuid)
+}
+
+// DeleteAlias mocks base method.
+func (m *MockIArtistService) DeleteAlias(uuid, aliasUUID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlias", uuid, aliasUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlias indicates an expected call of DeleteAlias.
+func (mr *MockIArtistServiceMockRecorder) DeleteAlias(uuid, aliasUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockIArtistService)(nil).DeleteAlias), uuid, aliasUUID)
+}
+
+// Find mocks base method.
+func (m *MockIArtistService) Find(uuid pgtype.UUID) (*dto.ArtistRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", uuid)
+	ret0, _ := ret[0].(*dto.ArtistRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIArtistServiceMockRecorder) Find(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIArtistService)(nil).Find), uuid)
+}
+
+// ListAliases mocks base method.
+func (m *MockIArtistService) ListAliases(uuid pgtype.UUID) ([]dto.ArtistAliasRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAliases", uuid)
+	ret0, _ := ret[0].([]dto.ArtistAliasRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAliases indicates an expected call of ListAliases.
+func (mr *MockIArtistServiceMockRecorder) ListAliases(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockIArtistService)(nil).ListAliases), uuid)
+}
+
+// ListAudios mocks base method.
+func (m *MockIArtistService) ListAudios(uuid pgtype.UUID, pag crud.Pagination) ([]dto.AudioRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAudios", uuid, pag)
+	ret0, _ := ret[0].([]dto.AudioRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAudios indicates an expected call of ListAudios.
+func (mr *MockIArtistServiceMockRecorder) ListAudios(uuid, pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAudios", reflect.TypeOf((*MockIArtistService)(nil).ListAudios), uuid, pag)
+}
+
+// ListPag mocks base method.
+func (m *MockIArtistService) ListPag(pag crud.Pagination) ([]dto.ArtistRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPag", pag)
+	ret0, _ := ret[0].([]dto.ArtistRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPag indicates an expected call of ListPag.
+func (mr *MockIArtistServiceMockRecorder) ListPag(pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPag", reflect.TypeOf((*MockIArtistService)(nil).ListPag), pag)
+}
+
+// Merge mocks base method.
+func (m *MockIArtistService) Merge(targetUUID, sourceUUID pgtype.UUID, editor string) (*dto.ArtistRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", targetUUID, sourceUUID, editor)
+	ret0, _ := ret[0].(*dto.ArtistRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockIArtistServiceMockRecorder) Merge(targetUUID, sourceUUID, editor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockIArtistService)(nil).Merge), targetUUID, sourceUUID, editor)
+}
+
+// NormalizeNames mocks base method.
+func (m *MockIArtistService) NormalizeNames() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NormalizeNames")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NormalizeNames indicates an expected call of NormalizeNames.
+func (mr *MockIArtistServiceMockRecorder) NormalizeNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NormalizeNames", reflect.TypeOf((*MockIArtistService)(nil).NormalizeNames))
+}
+
+// Update mocks base method.
+func (m *MockIArtistService) Update(uuid pgtype.UUID, artist *dto.ArtistUpdate) (*dto.ArtistRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", uuid, artist)
+	ret0, _ := ret[0].(*dto.ArtistRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIArtistServiceMockRecorder) Update(uuid, artist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIArtistService)(nil).Update), uuid, artist)
+}
+
+// MockIAlbumService is a mock of IAlbumService interface.
+type MockIAlbumService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlbumServiceMockRecorder
+}
+
+// MockIAlbumServiceMockRecorder is the mock recorder for MockIAlbumService.
+type MockIAlbumServiceMockRecorder struct {
+	mock *MockIAlbumService
+}
+
+// NewMockIAlbumService creates a new mock instance.
+func NewMockIAlbumService(ctrl *gomock.Controller) *MockIAlbumService {
+	mock := &MockIAlbumService{ctrl: ctrl}
+	mock.recorder = &MockIAlbumServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlbumService) EXPECT() *MockIAlbumServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTrack mocks base method.
+func (m *MockIAlbumService) AddTrack(uuid pgtype.UUID, track *dto.AlbumTrackCreate) (*dto.AlbumReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrack", uuid, track)
+	ret0, _ := ret[0].(*dto.AlbumReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTrack indicates an expected call of AddTrack.
+func (mr *MockIAlbumServiceMockRecorder) AddTrack(uuid, track any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrack", reflect.TypeOf((*MockIAlbumService)(nil).AddTrack), uuid, track)
+}
+
+// Create mocks base method.
+func (m *MockIAlbumService) Create(album *dto.AlbumCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", album)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIAlbumServiceMockRecorder) Create(album any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIAlbumService)(nil).Create), album)
+}
+
+// Delete mocks base method.
+func (m *MockIAlbumService) Delete(uuid pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAlbumServiceMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAlbumService)(nil).Delete), uuid)
+}
+
+// Find mocks base method.
+func (m *MockIAlbumService) Find(uuid pgtype.UUID) (*dto.AlbumReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", uuid)
+	ret0, _ := ret[0].(*dto.AlbumReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIAlbumServiceMockRecorder) Find(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIAlbumService)(nil).Find), uuid)
+}
+
+// ListPag mocks base method.
+func (m *MockIAlbumService) ListPag(pag crud.Pagination) ([]dto.AlbumRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPag", pag)
+	ret0, _ := ret[0].([]dto.AlbumRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPag indicates an expected call of ListPag.
+func (mr *MockIAlbumServiceMockRecorder) ListPag(pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPag", reflect.TypeOf((*MockIAlbumService)(nil).ListPag), pag)
+}
+
+// RemoveTrack mocks base method.
+func (m *MockIAlbumService) RemoveTrack(uuid, audioUUID pgtype.UUID) (*dto.AlbumReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTrack", uuid, audioUUID)
+	ret0, _ := ret[0].(*dto.AlbumReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTrack indicates an expected call of RemoveTrack.
+func (mr *MockIAlbumServiceMockRecorder) RemoveTrack(uuid, audioUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTrack", reflect.TypeOf((*MockIAlbumService)(nil).RemoveTrack), uuid, audioUUID)
+}
+
+// ReplaceTracks mocks base method.
+func (m *MockIAlbumService) ReplaceTracks(uuid pgtype.UUID, audioUUIDs []pgtype.UUID) (*dto.AlbumReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTracks", uuid, audioUUIDs)
+	ret0, _ := ret[0].(*dto.AlbumReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceTracks indicates an expected call of ReplaceTracks.
+func (mr *MockIAlbumServiceMockRecorder) ReplaceTracks(uuid, audioUUIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTracks", reflect.TypeOf((*MockIAlbumService)(nil).ReplaceTracks), uuid, audioUUIDs)
+}
+
+// Update mocks base method.
+func (m *MockIAlbumService) Update(uuid pgtype.UUID, album *dto.AlbumUpdate) (*dto.AlbumRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", uuid, album)
+	ret0, _ := ret[0].(*dto.AlbumRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIAlbumServiceMockRecorder) Update(uuid, album any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIAlbumService)(nil).Update), uuid, album)
+}
+
+// MockICreditService is a mock of ICreditService interface.
+type MockICreditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockICreditServiceMockRecorder
+}
+
+// MockICreditServiceMockRecorder is the mock recorder for MockICreditService.
+type MockICreditServiceMockRecorder struct {
+	mock *MockICreditService
+}
+
+// NewMockICreditService creates a new mock instance.
+func NewMockICreditService(ctrl *gomock.Controller) *MockICreditService {
+	mock := &MockICreditService{ctrl: ctrl}
+	mock.recorder = &MockICreditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICreditService) EXPECT() *MockICreditServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockICreditService) Create(audioUUID pgtype.UUID, credit *dto.CreditCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", audioUUID, credit)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICreditServiceMockRecorder) Create(audioUUID, credit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICreditService)(nil).Create), audioUUID, credit)
+}
+
+// Delete mocks base method.
+func (m *MockICreditService) Delete(audioUUID, creditUUID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", audioUUID, creditUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICreditServiceMockRecorder) Delete(audioUUID, creditUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICreditService)(nil).Delete), audioUUID, creditUUID)
+}
+
+// ListByAudio mocks base method.
+func (m *MockICreditService) ListByAudio(audioUUID pgtype.UUID) ([]dto.CreditRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAudio", audioUUID)
+	ret0, _ := ret[0].([]dto.CreditRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAudio indicates an expected call of ListByAudio.
+func (mr *MockICreditServiceMockRecorder) ListByAudio(audioUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAudio", reflect.TypeOf((*MockICreditService)(nil).ListByAudio), audioUUID)
+}
+
+// MockIGenreService is a mock of IGenreService interface.
+type MockIGenreService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGenreServiceMockRecorder
+}
+
+// MockIGenreServiceMockRecorder is the mock recorder for MockIGenreService.
+type MockIGenreServiceMockRecorder struct {
+	mock *MockIGenreService
+}
+
+// NewMockIGenreService creates a new mock instance.
+func NewMockIGenreService(ctrl *gomock.Controller) *MockIGenreService {
+	mock := &MockIGenreService{ctrl: ctrl}
+	mock.recorder = &MockIGenreServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGenreService) EXPECT() *MockIGenreServiceMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockIGenreService) Attach(audioUUID, genreUUID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", audioUUID, genreUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockIGenreServiceMockRecorder) Attach(audioUUID, genreUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockIGenreService)(nil).Attach), audioUUID, genreUUID)
+}
+
+// Create mocks base method.
+func (m *MockIGenreService) Create(genre *dto.GenreCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", genre)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIGenreServiceMockRecorder) Create(genre any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIGenreService)(nil).Create), genre)
+}
+
+// Delete mocks base method.
+func (m *MockIGenreService) Delete(uuid pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIGenreServiceMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIGenreService)(nil).Delete), uuid)
+}
+
+// Detach mocks base method.
+func (m *MockIGenreService) Detach(audioUUID, genreUUID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detach", audioUUID, genreUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockIGenreServiceMockRecorder) Detach(audioUUID, genreUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockIGenreService)(nil).Detach), audioUUID, genreUUID)
+}
+
+// Find mocks base method.
+func (m *MockIGenreService) Find(uuid pgtype.UUID) (*dto.GenreRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", uuid)
+	ret0, _ := ret[0].(*dto.GenreRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIGenreServiceMockRecorder) Find(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIGenreService)(nil).Find), uuid)
+}
+
+// ListPag mocks base method.
+func (m *MockIGenreService) ListPag(pag crud.Pagination) ([]dto.GenreRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPag", pag)
+	ret0, _ := ret[0].([]dto.GenreRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPag indicates an expected call of ListPag.
+func (mr *MockIGenreServiceMockRecorder) ListPag(pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPag", reflect.TypeOf((*MockIGenreService)(nil).ListPag), pag)
+}
+
+// MockITagService is a mock of ITagService interface.
+type MockITagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagServiceMockRecorder
+}
+
+// MockITagServiceMockRecorder is the mock recorder for MockITagService.
+type MockITagServiceMockRecorder struct {
+	mock *MockITagService
+}
+
+// NewMockITagService creates a new mock instance.
+func NewMockITagService(ctrl *gomock.Controller) *MockITagService {
+	mock := &MockITagService{ctrl: ctrl}
+	mock.recorder = &MockITagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagService) EXPECT() *MockITagServiceMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockITagService) Attach(audioUUID pgtype.UUID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", audioUUID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockITagServiceMockRecorder) Attach(audioUUID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockITagService)(nil).Attach), audioUUID, name)
+}
+
+// Detach mocks base method.
+func (m *MockITagService) Detach(audioUUID pgtype.UUID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detach", audioUUID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockITagServiceMockRecorder) Detach(audioUUID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockITagService)(nil).Detach), audioUUID, name)
+}
+
+// ListPag mocks base method.
+func (m *MockITagService) ListPag(pag crud.Pagination) ([]dto.TagRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPag", pag)
+	ret0, _ := ret[0].([]dto.TagRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPag indicates an expected call of ListPag.
+func (mr *MockITagServiceMockRecorder) ListPag(pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPag", reflect.TypeOf((*MockITagService)(nil).ListPag), pag)
+}
+
+// MockILinkService is a mock of ILinkService interface.
+type MockILinkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockILinkServiceMockRecorder
+}
+
+// MockILinkServiceMockRecorder is the mock recorder for MockILinkService.
+type MockILinkServiceMockRecorder struct {
+	mock *MockILinkService
+}
+
+// NewMockILinkService creates a new mock instance.
+func NewMockILinkService(ctrl *gomock.Controller) *MockILinkService {
+	mock := &MockILinkService{ctrl: ctrl}
+	mock.recorder = &MockILinkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILinkService) EXPECT() *MockILinkServiceMockRecorder {
+	return m.recorder
+}
+
+// CanonicalizeURLs mocks base method.
+func (m *MockILinkService) CanonicalizeURLs() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanonicalizeURLs")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CanonicalizeURLs indicates an expected call of CanonicalizeURLs.
+func (mr *MockILinkServiceMockRecorder) CanonicalizeURLs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalizeURLs", reflect.TypeOf((*MockILinkService)(nil).CanonicalizeURLs))
+}
+
+// Create mocks base method.
+func (m *MockILinkService) Create(audioUUID pgtype.UUID, link *dto.LinkCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", audioUUID, link)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockILinkServiceMockRecorder) Create(audioUUID, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockILinkService)(nil).Create), audioUUID, link)
+}
+
+// Delete mocks base method.
+func (m *MockILinkService) Delete(audioUUID, linkUUID pgtype.UUID, editor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", audioUUID, linkUUID, editor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockILinkServiceMockRecorder) Delete(audioUUID, linkUUID, editor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockILinkService)(nil).Delete), audioUUID, linkUUID, editor)
+}
+
+// ListByAudio mocks base method.
+func (m *MockILinkService) ListByAudio(audioUUID pgtype.UUID) ([]dto.LinkRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAudio", audioUUID)
+	ret0, _ := ret[0].([]dto.LinkRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAudio indicates an expected call of ListByAudio.
+func (mr *MockILinkServiceMockRecorder) ListByAudio(audioUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAudio", reflect.TypeOf((*MockILinkService)(nil).ListByAudio), audioUUID)
+}
+
+// SetPrimary mocks base method.
+func (m *MockILinkService) SetPrimary(audioUUID, linkUUID pgtype.UUID, editor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimary", audioUUID, linkUUID, editor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrimary indicates an expected call of SetPrimary.
+func (mr *MockILinkServiceMockRecorder) SetPrimary(audioUUID, linkUUID, editor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimary", reflect.TypeOf((*MockILinkService)(nil).SetPrimary), audioUUID, linkUUID, editor)
+}
+
+// MockICoverService is a mock of ICoverService interface.
+type MockICoverService struct {
+	ctrl     *gomock.Controller
+	recorder *MockICoverServiceMockRecorder
+}
+
+// MockICoverServiceMockRecorder is the mock recorder for MockICoverService.
+type MockICoverServiceMockRecorder struct {
+	mock *MockICoverService
+}
+
+// NewMockICoverService creates a new mock instance.
+func NewMockICoverService(ctrl *gomock.Controller) *MockICoverService {
+	mock := &MockICoverService{ctrl: ctrl}
+	mock.recorder = &MockICoverServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICoverService) EXPECT() *MockICoverServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockICoverService) Delete(target string, uuid pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", target, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICoverServiceMockRecorder) Delete(target, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICoverService)(nil).Delete), target, uuid)
+}
+
+// Set mocks base method.
+func (m *MockICoverService) Set(target string, uuid pgtype.UUID, data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", target, uuid, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockICoverServiceMockRecorder) Set(target, uuid, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockICoverService)(nil).Set), target, uuid, data)
+}
+
+// MockIAudioFileService is a mock of IAudioFileService interface.
+type MockIAudioFileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAudioFileServiceMockRecorder
+}
+
+// MockIAudioFileServiceMockRecorder is the mock recorder for MockIAudioFileService.
+type MockIAudioFileServiceMockRecorder struct {
+	mock *MockIAudioFileService
+}
+
+// NewMockIAudioFileService creates a new mock instance.
+func NewMockIAudioFileService(ctrl *gomock.Controller) *MockIAudioFileService {
+	mock := &MockIAudioFileService{ctrl: ctrl}
+	mock.recorder = &MockIAudioFileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAudioFileService) EXPECT() *MockIAudioFileServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateFromUpload mocks base method.
+func (m *MockIAudioFileService) CreateFromUpload(body io.Reader, editor string) (pgtype.UUID, *dto.AudioFileUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromUpload", body, editor)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(*dto.AudioFileUpload)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateFromUpload indicates an expected call of CreateFromUpload.
+func (mr *MockIAudioFileServiceMockRecorder) CreateFromUpload(body, editor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromUpload", reflect.TypeOf((*MockIAudioFileService)(nil).CreateFromUpload), body, editor)
+}
+
+// Open mocks base method.
+func (m *MockIAudioFileService) Open(audioUUID pgtype.UUID) (*dto.AudioFileRead, io.ReadSeekCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", audioUUID)
+	ret0, _ := ret[0].(*dto.AudioFileRead)
+	ret1, _ := ret[1].(io.ReadSeekCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockIAudioFileServiceMockRecorder) Open(audioUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockIAudioFileService)(nil).Open), audioUUID)
+}
+
+// Upload mocks base method.
+func (m *MockIAudioFileService) Upload(audioUUID pgtype.UUID, body io.Reader) (*dto.AudioFileUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", audioUUID, body)
+	ret0, _ := ret[0].(*dto.AudioFileUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockIAudioFileServiceMockRecorder) Upload(audioUUID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockIAudioFileService)(nil).Upload), audioUUID, body)
+}
+
+// MockIPlaylistService is a mock of IPlaylistService interface.
+type MockIPlaylistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPlaylistServiceMockRecorder
+}
+
+// MockIPlaylistServiceMockRecorder is the mock recorder for MockIPlaylistService.
+type MockIPlaylistServiceMockRecorder struct {
+	mock *MockIPlaylistService
+}
+
+// NewMockIPlaylistService creates a new mock instance.
+func NewMockIPlaylistService(ctrl *gomock.Controller) *MockIPlaylistService {
+	mock := &MockIPlaylistService{ctrl: ctrl}
+	mock.recorder = &MockIPlaylistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPlaylistService) EXPECT() *MockIPlaylistServiceMockRecorder {
+	return m.recorder
+}
+
+// AddEntry mocks base method.
+func (m *MockIPlaylistService) AddEntry(uuid pgtype.UUID, owner string, entry *dto.PlaylistEntryCreate) (*dto.PlaylistReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEntry", uuid, owner, entry)
+	ret0, _ := ret[0].(*dto.PlaylistReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEntry indicates an expected call of AddEntry.
+func (mr *MockIPlaylistServiceMockRecorder) AddEntry(uuid, owner, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEntry", reflect.TypeOf((*MockIPlaylistService)(nil).AddEntry), uuid, owner, entry)
+}
+
+// Create mocks base method.
+func (m *MockIPlaylistService) Create(playlist *dto.PlaylistCreate) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", playlist)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPlaylistServiceMockRecorder) Create(playlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPlaylistService)(nil).Create), playlist)
+}
+
+// Delete mocks base method.
+func (m *MockIPlaylistService) Delete(uuid pgtype.UUID, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIPlaylistServiceMockRecorder) Delete(uuid, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIPlaylistService)(nil).Delete), uuid, owner)
+}
+
+// Find mocks base method.
+func (m *MockIPlaylistService) Find(uuid pgtype.UUID) (*dto.PlaylistReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", uuid)
+	ret0, _ := ret[0].(*dto.PlaylistReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIPlaylistServiceMockRecorder) Find(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIPlaylistService)(nil).Find), uuid)
+}
+
+// ListByOwner mocks base method.
+func (m *MockIPlaylistService) ListByOwner(owner string, pag crud.Pagination) ([]dto.PlaylistRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwner", owner, pag)
+	ret0, _ := ret[0].([]dto.PlaylistRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwner indicates an expected call of ListByOwner.
+func (mr *MockIPlaylistServiceMockRecorder) ListByOwner(owner, pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockIPlaylistService)(nil).ListByOwner), owner, pag)
+}
+
+// MoveEntry mocks base method.
+func (m *MockIPlaylistService) MoveEntry(uuid, entryUUID pgtype.UUID, owner string, position int) (*dto.PlaylistReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveEntry", uuid, entryUUID, owner, position)
+	ret0, _ := ret[0].(*dto.PlaylistReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveEntry indicates an expected call of MoveEntry.
+func (mr *MockIPlaylistServiceMockRecorder) MoveEntry(uuid, entryUUID, owner, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveEntry", reflect.TypeOf((*MockIPlaylistService)(nil).MoveEntry), uuid, entryUUID, owner, position)
+}
+
+// RemoveEntry mocks base method.
+func (m *MockIPlaylistService) RemoveEntry(uuid, entryUUID pgtype.UUID, owner string) (*dto.PlaylistReadFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEntry", uuid, entryUUID, owner)
+	ret0, _ := ret[0].(*dto.PlaylistReadFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEntry indicates an expected call of RemoveEntry.
+func (mr *MockIPlaylistServiceMockRecorder) RemoveEntry(uuid, entryUUID, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEntry", reflect.TypeOf((*MockIPlaylistService)(nil).RemoveEntry), uuid, entryUUID, owner)
+}
+
+// Update mocks base method.
+func (m *MockIPlaylistService) Update(uuid pgtype.UUID, owner string, playlist *dto.PlaylistUpdate) (*dto.PlaylistRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", uuid, owner, playlist)
+	ret0, _ := ret[0].(*dto.PlaylistRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIPlaylistServiceMockRecorder) Update(uuid, owner, playlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIPlaylistService)(nil).Update), uuid, owner, playlist)
+}
+
+// MockIFavoriteService is a mock of IFavoriteService interface.
+type MockIFavoriteService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFavoriteServiceMockRecorder
+}
+
+// MockIFavoriteServiceMockRecorder is the mock recorder for MockIFavoriteService.
+type MockIFavoriteServiceMockRecorder struct {
+	mock *MockIFavoriteService
+}
+
+// NewMockIFavoriteService creates a new mock instance.
+func NewMockIFavoriteService(ctrl *gomock.Controller) *MockIFavoriteService {
+	mock := &MockIFavoriteService{ctrl: ctrl}
+	mock.recorder = &MockIFavoriteServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFavoriteService) EXPECT() *MockIFavoriteServiceMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIFavoriteService) Add(userID string, audioUUID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", userID, audioUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIFavoriteServiceMockRecorder) Add(userID, audioUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIFavoriteService)(nil).Add), userID, audioUUID)
+}
+
+// Filter mocks base method.
+func (m *MockIFavoriteService) Filter(userID string, audioUUIDs []pgtype.UUID) (map[pgtype.UUID]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", userID, audioUUIDs)
+	ret0, _ := ret[0].(map[pgtype.UUID]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIFavoriteServiceMockRecorder) Filter(userID, audioUUIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIFavoriteService)(nil).Filter), userID, audioUUIDs)
+}
+
+// ListPag mocks base method.
+func (m *MockIFavoriteService) ListPag(userID string, pag crud.Pagination) ([]dto.FavoriteRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPag", userID, pag)
+	ret0, _ := ret[0].([]dto.FavoriteRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPag indicates an expected call of ListPag.
+func (mr *MockIFavoriteServiceMockRecorder) ListPag(userID, pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPag", reflect.TypeOf((*MockIFavoriteService)(nil).ListPag), userID, pag)
+}
+
+// Remove mocks base method.
+func (m *MockIFavoriteService) Remove(userID string, audioUUID pgtype.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", userID, audioUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIFavoriteServiceMockRecorder) Remove(userID, audioUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIFavoriteService)(nil).Remove), userID, audioUUID)
+}
+
+// MockIPlayService is a mock of IPlayService interface.
+type MockIPlayService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPlayServiceMockRecorder
+}
+
+// MockIPlayServiceMockRecorder is the mock recorder for MockIPlayService.
+type MockIPlayServiceMockRecorder struct {
+	mock *MockIPlayService
+}
+
+// NewMockIPlayService creates a new mock instance.
+func NewMockIPlayService(ctrl *gomock.Controller) *MockIPlayService {
+	mock := &MockIPlayService{ctrl: ctrl}
+	mock.recorder = &MockIPlayServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPlayService) EXPECT() *MockIPlayServiceMockRecorder {
+	return m.recorder
+}
+
+// History mocks base method.
+func (m *MockIPlayService) History(userID string, pag crud.Pagination) ([]dto.PlayHistoryRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", userID, pag)
+	ret0, _ := ret[0].([]dto.PlayHistoryRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockIPlayServiceMockRecorder) History(userID, pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockIPlayService)(nil).History), userID, pag)
+}
+
+// Record mocks base method.
+func (m *MockIPlayService) Record(audioUUID pgtype.UUID, play *dto.PlayCreate) (*dto.PlayRead, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", audioUUID, play)
+	ret0, _ := ret[0].(*dto.PlayRead)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockIPlayServiceMockRecorder) Record(audioUUID, play any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockIPlayService)(nil).Record), audioUUID, play)
+}
+
+// MockIChartService is a mock of IChartService interface.
+type MockIChartService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIChartServiceMockRecorder
+}
+
+// MockIChartServiceMockRecorder is the mock recorder for MockIChartService.
+type MockIChartServiceMockRecorder struct {
+	mock *MockIChartService
+}
+
+// NewMockIChartService creates a new mock instance.
+func NewMockIChartService(ctrl *gomock.Controller) *MockIChartService {
+	mock := &MockIChartService{ctrl: ctrl}
+	mock.recorder = &MockIChartServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIChartService) EXPECT() *MockIChartServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockIChartService) List(period, kind string, pag crud.Pagination) ([]dto.ChartEntryRead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", period, kind, pag)
+	ret0, _ := ret[0].([]dto.ChartEntryRead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIChartServiceMockRecorder) List(period, kind, pag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIChartService)(nil).List), period, kind, pag)
+}
+
+// Refresh mocks base method.
+func (m *MockIChartService) Refresh() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockIChartServiceMockRecorder) Refresh() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockIChartService)(nil).Refresh))
+}
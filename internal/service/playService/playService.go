@@ -0,0 +1,62 @@
+package playService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type PlayService struct {
+	r           repo.Repository
+	l           logging.Logger
+	dedupWindow time.Duration
+}
+
+type Deps struct {
+	Repo        repo.Repository
+	Logger      logging.Logger
+	DedupWindow time.Duration
+}
+
+func NewPlayService(d *Deps) *PlayService {
+	return &PlayService{
+		r:           d.Repo,
+		l:           d.Logger,
+		dedupWindow: d.DedupWindow,
+	}
+}
+
+// Record
+// record play of audio, play time defaults to now. Return false
+// with already recorded play if user played audio within dedup window
+func (s *PlayService) Record(audioUUID pgtype.UUID, play *dto.PlayCreate) (*dto.PlayRead, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !play.PlayedAt.Valid {
+		play.PlayedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	}
+
+	readPlay, created, err := s.r.Play.Create(ctx, audioUUID, play, s.dedupWindow)
+	if err != nil {
+		s.l.Error("Error on record play: ", err)
+	}
+	return readPlay, created, err
+}
+
+// History
+// return audios played by user, recent plays first
+func (s *PlayService) History(userID string, pag crud.Pagination) ([]dto.PlayHistoryRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	history, err := s.r.Play.ListHistoryByUserPag(ctx, userID, pag)
+	if err != nil {
+		s.l.Error("Error on list play history: ", err)
+	}
+	return history, err
+}
@@ -0,0 +1,173 @@
+package playlistService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"errors"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+// ErrNotOwner
+// playlist can be changed by its owner only
+var ErrNotOwner = errors.New("playlist belongs to another user")
+
+type PlaylistService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewPlaylistService(d *Deps) *PlaylistService {
+	return &PlaylistService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *PlaylistService) Create(playlist *dto.PlaylistCreate) (pgtype.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uuid, err := s.r.Playlist.Create(ctx, playlist)
+	if err != nil {
+		s.l.Error("Error on creating playlist: ", err)
+	}
+	return uuid, err
+}
+
+// Find
+// return playlist with ordered entries
+func (s *PlaylistService) Find(uuid pgtype.UUID) (*dto.PlaylistReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return s.findWithEntries(ctx, uuid)
+}
+
+// ListByOwner
+// list playlists of owner, all playlists if owner is empty
+func (s *PlaylistService) ListByOwner(owner string, pag crud.Pagination) ([]dto.PlaylistRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	playlists, err := s.r.Playlist.ListByOwnerPag(ctx, owner, pag)
+	if err != nil {
+		s.l.Error("Error on list playlists: ", err)
+	}
+	return playlists, err
+}
+
+func (s *PlaylistService) Update(uuid pgtype.UUID, owner string, playlist *dto.PlaylistUpdate) (*dto.PlaylistRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.checkOwner(ctx, uuid, owner); err != nil {
+		return nil, err
+	}
+
+	readPlaylist, err := s.r.Playlist.Update(ctx, uuid, playlist)
+	if err != nil {
+		s.l.Error("Error on update playlist: ", err)
+	}
+	return readPlaylist, err
+}
+
+func (s *PlaylistService) Delete(uuid pgtype.UUID, owner string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.checkOwner(ctx, uuid, owner); err != nil {
+		return err
+	}
+
+	err := s.r.Playlist.Delete(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on delete playlist: ", err)
+	}
+	return err
+}
+
+// AddEntry
+// add audio to playlist and return playlist with updated entries
+func (s *PlaylistService) AddEntry(uuid pgtype.UUID, owner string, entry *dto.PlaylistEntryCreate) (*dto.PlaylistReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.checkOwner(ctx, uuid, owner); err != nil {
+		return nil, err
+	}
+
+	err := s.r.Playlist.AddEntry(ctx, uuid, entry)
+	if err != nil {
+		s.l.Error("Error on add playlist entry: ", err)
+		return nil, err
+	}
+	return s.findWithEntries(ctx, uuid)
+}
+
+// RemoveEntry
+// remove entry from playlist and return playlist with updated entries
+func (s *PlaylistService) RemoveEntry(uuid, entryUUID pgtype.UUID, owner string) (*dto.PlaylistReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.checkOwner(ctx, uuid, owner); err != nil {
+		return nil, err
+	}
+
+	err := s.r.Playlist.RemoveEntry(ctx, uuid, entryUUID)
+	if err != nil {
+		s.l.Error("Error on remove playlist entry: ", err)
+		return nil, err
+	}
+	return s.findWithEntries(ctx, uuid)
+}
+
+// MoveEntry
+// move entry to position and return playlist with updated entries
+func (s *PlaylistService) MoveEntry(uuid, entryUUID pgtype.UUID, owner string, position int) (*dto.PlaylistReadFull, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.checkOwner(ctx, uuid, owner); err != nil {
+		return nil, err
+	}
+
+	err := s.r.Playlist.MoveEntry(ctx, uuid, entryUUID, position)
+	if err != nil {
+		s.l.Error("Error on move playlist entry: ", err)
+		return nil, err
+	}
+	return s.findWithEntries(ctx, uuid)
+}
+
+// checkOwner
+// return ErrNotOwner if playlist belongs to another user.
+// Owner never changes, so check is not repeated in transaction
+func (s *PlaylistService) checkOwner(ctx context.Context, uuid pgtype.UUID, owner string) error {
+	playlist, err := s.r.Playlist.FindByUUID(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding playlist by uuid: ", err)
+		return err
+	}
+	if playlist.Owner != owner {
+		return ErrNotOwner
+	}
+	return nil
+}
+
+func (s *PlaylistService) findWithEntries(ctx context.Context, uuid pgtype.UUID) (*dto.PlaylistReadFull, error) {
+	playlist, err := s.r.Playlist.FindByUUIDWithEntries(ctx, uuid)
+	if err != nil {
+		s.l.Error("Error on finding playlist by uuid: ", err)
+	}
+	return playlist, err
+}
@@ -4,39 +4,128 @@ import (
 	"eMobile/internal/crud"
 	"eMobile/internal/dto"
 	"eMobile/internal/repo"
+	"eMobile/internal/service/albumService"
+	"eMobile/internal/service/artistService"
+	"eMobile/internal/service/audioFileService"
 	"eMobile/internal/service/audioService"
+	"eMobile/internal/service/chartService"
+	"eMobile/internal/service/coverService"
+	"eMobile/internal/service/creditService"
+	"eMobile/internal/service/favoriteService"
+	"eMobile/internal/service/genreService"
+	"eMobile/internal/service/linkService"
 	"eMobile/internal/service/lyricService"
+	"eMobile/internal/service/playService"
+	"eMobile/internal/service/playlistService"
+	"eMobile/internal/service/tagService"
+	"eMobile/pkg/blob"
 	"eMobile/pkg/logging"
+	"eMobile/pkg/lyrics"
 	"github.com/jackc/pgx/v5/pgtype"
+	"io"
 	"net/http"
+	"time"
 )
 
 type Service struct {
-	Audio IAudioService
-	Lyric ILyricService
+	Audio    IAudioService
+	Lyric    ILyricService
+	Artist   IArtistService
+	Album    IAlbumService
+	Credit   ICreditService
+	Genre    IGenreService
+	Tag      ITagService
+	Link     ILinkService
+	Cover    ICoverService
+	File     IAudioFileService
+	Playlist IPlaylistService
+	Favorite IFavoriteService
+	Play     IPlayService
+	Chart    IChartService
 }
 
 type Deps struct {
-	Repo       repo.Repository
-	Logger     logging.Logger
-	HttpClient *http.Client
-	InfoURL    string
+	Repo            repo.Repository
+	Logger          logging.Logger
+	HttpClient      *http.Client
+	InfoURL         string
+	InfoCache       audioService.InfoCache
+	Classifier      audioService.Classifier
+	Blob            blob.Store
+	PlayDedupWindow time.Duration
 }
 
 // NewService
 // return all-in-one service
 func NewService(d *Deps) Service {
+	audio := audioService.NewAudioService(&audioService.Deps{
+		Repo:       d.Repo,
+		Logger:     d.Logger,
+		Http:       d.HttpClient,
+		InfoURL:    d.InfoURL,
+		InfoCache:  d.InfoCache,
+		Classifier: d.Classifier,
+		Blob:       d.Blob,
+	})
+
 	return Service{
-		Audio: audioService.NewAudioService(&audioService.Deps{
-			Repo:    d.Repo,
-			Logger:  d.Logger,
-			Http:    d.HttpClient,
-			InfoURL: d.InfoURL,
-		}),
+		Audio: audio,
 		Lyric: lyricService.NewLyricService(&lyricService.Deps{
 			Repo:   d.Repo,
 			Logger: d.Logger,
 		}),
+		Artist: artistService.NewArtistService(&artistService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Album: albumService.NewAlbumService(&albumService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Credit: creditService.NewCreditService(&creditService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Genre: genreService.NewGenreService(&genreService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Tag: tagService.NewTagService(&tagService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Link: linkService.NewLinkService(&linkService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Cover: coverService.NewCoverService(&coverService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+			Blob:   d.Blob,
+		}),
+		File: audioFileService.NewAudioFileService(&audioFileService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+			Blob:   d.Blob,
+			Audio:  audio,
+		}),
+		Playlist: playlistService.NewPlaylistService(&playlistService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Favorite: favoriteService.NewFavoriteService(&favoriteService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
+		Play: playService.NewPlayService(&playService.Deps{
+			Repo:        d.Repo,
+			Logger:      d.Logger,
+			DedupWindow: d.PlayDedupWindow,
+		}),
+		Chart: chartService.NewChartService(&chartService.Deps{
+			Repo:   d.Repo,
+			Logger: d.Logger,
+		}),
 	}
 }
 
@@ -47,11 +136,109 @@ type IAudioService interface {
 	Find(uuid pgtype.UUID) (*dto.AudioRead, error)
 	FindWithLyric(uuid pgtype.UUID) (*dto.AudioReadFull, error)
 	ListByFilter(filter *dto.AudioFilter, pag crud.Pagination) ([]dto.AudioRead, error)
+	Stats(filter *dto.AudioFilter) (*dto.AudioStats, error)
 	ListPag(pag crud.Pagination) ([]dto.AudioRead, error)
 	Update(uuid pgtype.UUID, audio *dto.AudioUpdate) (*dto.AudioRead, error)
 	Delete(uuid pgtype.UUID) error
+	Refresh(uuid pgtype.UUID) (*dto.AudioRead, error)
+	ListProvenance(uuid pgtype.UUID) ([]dto.ProvenanceRead, error)
+	Analyze(uuid pgtype.UUID) (*lyrics.Analysis, error)
+	ClassifyLyrics() error
 }
 
 type ILyricService interface {
 	ListByAudioPag(uuid pgtype.UUID, pag crud.Pagination) ([]dto.LyricRead, error)
 }
+
+type IArtistService interface {
+	Create(artist *dto.ArtistCreate) (pgtype.UUID, error)
+	Find(uuid pgtype.UUID) (*dto.ArtistRead, error)
+	ListPag(pag crud.Pagination) ([]dto.ArtistRead, error)
+	ListAudios(uuid pgtype.UUID, pag crud.Pagination) ([]dto.AudioRead, error)
+	Update(uuid pgtype.UUID, artist *dto.ArtistUpdate) (*dto.ArtistRead, error)
+	Delete(uuid pgtype.UUID) error
+	NormalizeNames() error
+	ListAliases(uuid pgtype.UUID) ([]dto.ArtistAliasRead, error)
+	CreateAlias(uuid pgtype.UUID, alias *dto.ArtistAliasCreate) (pgtype.UUID, error)
+	DeleteAlias(uuid, aliasUUID pgtype.UUID) error
+	Merge(targetUUID, sourceUUID pgtype.UUID, editor string) (*dto.ArtistRead, error)
+}
+
+type IAlbumService interface {
+	Create(album *dto.AlbumCreate) (pgtype.UUID, error)
+	Find(uuid pgtype.UUID) (*dto.AlbumReadFull, error)
+	ListPag(pag crud.Pagination) ([]dto.AlbumRead, error)
+	Update(uuid pgtype.UUID, album *dto.AlbumUpdate) (*dto.AlbumRead, error)
+	Delete(uuid pgtype.UUID) error
+	AddTrack(uuid pgtype.UUID, track *dto.AlbumTrackCreate) (*dto.AlbumReadFull, error)
+	RemoveTrack(uuid, audioUUID pgtype.UUID) (*dto.AlbumReadFull, error)
+	ReplaceTracks(uuid pgtype.UUID, audioUUIDs []pgtype.UUID) (*dto.AlbumReadFull, error)
+}
+
+type ICreditService interface {
+	ListByAudio(audioUUID pgtype.UUID) ([]dto.CreditRead, error)
+	Create(audioUUID pgtype.UUID, credit *dto.CreditCreate) (pgtype.UUID, error)
+	Delete(audioUUID, creditUUID pgtype.UUID) error
+}
+
+type IGenreService interface {
+	Create(genre *dto.GenreCreate) (pgtype.UUID, error)
+	Find(uuid pgtype.UUID) (*dto.GenreRead, error)
+	ListPag(pag crud.Pagination) ([]dto.GenreRead, error)
+	Delete(uuid pgtype.UUID) error
+	Attach(audioUUID, genreUUID pgtype.UUID) error
+	Detach(audioUUID, genreUUID pgtype.UUID) error
+}
+
+type ITagService interface {
+	ListPag(pag crud.Pagination) ([]dto.TagRead, error)
+	Attach(audioUUID pgtype.UUID, name string) error
+	Detach(audioUUID pgtype.UUID, name string) error
+}
+
+type ILinkService interface {
+	ListByAudio(audioUUID pgtype.UUID) ([]dto.LinkRead, error)
+	Create(audioUUID pgtype.UUID, link *dto.LinkCreate) (pgtype.UUID, error)
+	SetPrimary(audioUUID, linkUUID pgtype.UUID, editor string) error
+	Delete(audioUUID, linkUUID pgtype.UUID, editor string) error
+	CanonicalizeURLs() error
+}
+
+type ICoverService interface {
+	Set(target string, uuid pgtype.UUID, data []byte) (string, error)
+	Delete(target string, uuid pgtype.UUID) error
+}
+
+type IAudioFileService interface {
+	Upload(audioUUID pgtype.UUID, body io.Reader) (*dto.AudioFileUpload, error)
+	CreateFromUpload(body io.Reader, editor string) (pgtype.UUID, *dto.AudioFileUpload, error)
+	Open(audioUUID pgtype.UUID) (*dto.AudioFileRead, io.ReadSeekCloser, error)
+}
+
+type IPlaylistService interface {
+	Create(playlist *dto.PlaylistCreate) (pgtype.UUID, error)
+	Find(uuid pgtype.UUID) (*dto.PlaylistReadFull, error)
+	ListByOwner(owner string, pag crud.Pagination) ([]dto.PlaylistRead, error)
+	Update(uuid pgtype.UUID, owner string, playlist *dto.PlaylistUpdate) (*dto.PlaylistRead, error)
+	Delete(uuid pgtype.UUID, owner string) error
+	AddEntry(uuid pgtype.UUID, owner string, entry *dto.PlaylistEntryCreate) (*dto.PlaylistReadFull, error)
+	RemoveEntry(uuid, entryUUID pgtype.UUID, owner string) (*dto.PlaylistReadFull, error)
+	MoveEntry(uuid, entryUUID pgtype.UUID, owner string, position int) (*dto.PlaylistReadFull, error)
+}
+
+type IFavoriteService interface {
+	Add(userID string, audioUUID pgtype.UUID) error
+	Remove(userID string, audioUUID pgtype.UUID) error
+	ListPag(userID string, pag crud.Pagination) ([]dto.FavoriteRead, error)
+	Filter(userID string, audioUUIDs []pgtype.UUID) (map[pgtype.UUID]bool, error)
+}
+
+type IPlayService interface {
+	Record(audioUUID pgtype.UUID, play *dto.PlayCreate) (*dto.PlayRead, bool, error)
+	History(userID string, pag crud.Pagination) ([]dto.PlayHistoryRead, error)
+}
+
+type IChartService interface {
+	List(period, kind string, pag crud.Pagination) ([]dto.ChartEntryRead, error)
+	Refresh() error
+}
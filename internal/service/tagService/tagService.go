@@ -0,0 +1,61 @@
+package tagService
+
+import (
+	"context"
+	"eMobile/internal/crud"
+	"eMobile/internal/dto"
+	"eMobile/internal/repo"
+	"eMobile/pkg/logging"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type TagService struct {
+	r repo.Repository
+	l logging.Logger
+}
+
+type Deps struct {
+	Repo   repo.Repository
+	Logger logging.Logger
+}
+
+func NewTagService(d *Deps) *TagService {
+	return &TagService{
+		r: d.Repo,
+		l: d.Logger,
+	}
+}
+
+func (s *TagService) ListPag(pag crud.Pagination) ([]dto.TagRead, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tags, err := s.r.Tag.ListByPag(ctx, pag)
+	if err != nil {
+		s.l.Error("Error on list tags: ", err)
+	}
+	return tags, err
+}
+
+func (s *TagService) Attach(audioUUID pgtype.UUID, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Tag.Attach(ctx, audioUUID, name)
+	if err != nil {
+		s.l.Error("Error on attach tag to audio: ", err)
+	}
+	return err
+}
+
+func (s *TagService) Detach(audioUUID pgtype.UUID, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.r.Tag.Detach(ctx, audioUUID, name)
+	if err != nil {
+		s.l.Error("Error on detach tag from audio: ", err)
+	}
+	return err
+}
@@ -0,0 +1 @@
+ALTER TABLE public.audios DROP COLUMN source;
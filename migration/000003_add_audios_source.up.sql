@@ -0,0 +1,2 @@
+ALTER TABLE public.audios
+    ADD COLUMN source TEXT NOT NULL DEFAULT 'info';
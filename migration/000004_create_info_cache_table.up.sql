@@ -0,0 +1,13 @@
+CREATE TABLE public.info_cache
+(
+    key TEXT NOT NULL PRIMARY KEY ,
+    release_date DATE ,
+    text TEXT NOT NULL DEFAULT '' ,
+    link TEXT NOT NULL DEFAULT '' ,
+    not_found BOOLEAN NOT NULL DEFAULT FALSE ,
+    expires_at timestamptz NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL
+);
+
+CREATE INDEX idx_info_cache_expires_at
+    ON public.info_cache (expires_at);
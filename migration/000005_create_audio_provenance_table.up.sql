@@ -0,0 +1,20 @@
+CREATE TABLE public.audio_provenance
+(
+    audio_uuid UUID NOT NULL ,
+    field TEXT NOT NULL ,
+    source TEXT NOT NULL ,
+    editor TEXT NOT NULL DEFAULT '' ,
+    fetched_at timestamptz ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    PRIMARY KEY (audio_uuid, field) ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE
+);
+
+-- group and song always come from the creator, other fields from audios.source
+INSERT INTO public.audio_provenance (audio_uuid, field, source, fetched_at, updated_at)
+SELECT a.uuid, f.field,
+       CASE WHEN f.field IN ('group', 'song') THEN 'manual' ELSE a.source END,
+       CASE WHEN f.field NOT IN ('group', 'song') AND a.source = 'info' THEN a.created_at END,
+       a.updated_at
+FROM public.audios a
+CROSS JOIN (VALUES ('group'), ('song'), ('release_date'), ('link'), ('lyrics')) AS f(field);
@@ -0,0 +1,4 @@
+UPDATE public.audios SET release_date = created_at::date WHERE release_date IS NULL;
+
+ALTER TABLE public.audios
+    ALTER COLUMN release_date SET NOT NULL;
@@ -0,0 +1,3 @@
+-- info service may respond without release date
+ALTER TABLE public.audios
+    ALTER COLUMN release_date DROP NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE public.audios DROP COLUMN artist_uuid;
+DROP TABLE public.artists;
@@ -0,0 +1,30 @@
+CREATE TABLE public.artists
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    name TEXT NOT NULL ,
+    country TEXT NOT NULL DEFAULT '' ,
+    description TEXT NOT NULL DEFAULT '' ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_artists_name
+    ON public.artists (name);
+
+INSERT INTO public.artists (name)
+SELECT DISTINCT "group" FROM public.audios;
+
+-- audios."group" is kept as denormalized artist name
+ALTER TABLE public.audios
+    ADD COLUMN artist_uuid UUID REFERENCES artists(uuid) ON DELETE RESTRICT;
+
+UPDATE public.audios a
+SET artist_uuid = ar.uuid
+FROM public.artists ar
+WHERE ar.name = a."group";
+
+ALTER TABLE public.audios
+    ALTER COLUMN artist_uuid SET NOT NULL;
+
+CREATE INDEX idx_audios_artist_uuid
+    ON public.audios (artist_uuid);
@@ -0,0 +1,2 @@
+DROP TABLE public.artist_aliases;
+ALTER TABLE public.artists DROP COLUMN normalized_name;
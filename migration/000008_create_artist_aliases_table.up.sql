@@ -0,0 +1,22 @@
+-- normalized_name is filled by application, see pkg/canon
+ALTER TABLE public.artists
+    ADD COLUMN normalized_name TEXT;
+
+CREATE INDEX idx_artists_normalized_name
+    ON public.artists (normalized_name);
+
+CREATE TABLE public.artist_aliases
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    artist_uuid UUID NOT NULL ,
+    alias TEXT NOT NULL ,
+    normalized TEXT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (artist_uuid) REFERENCES artists(uuid) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_artist_aliases_normalized
+    ON public.artist_aliases (normalized);
+
+CREATE INDEX idx_artist_aliases_artist_uuid
+    ON public.artist_aliases (artist_uuid);
@@ -0,0 +1,2 @@
+DROP TABLE public.album_tracks;
+DROP TABLE public.albums;
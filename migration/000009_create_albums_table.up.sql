@@ -0,0 +1,33 @@
+-- compilations may have no single artist
+CREATE TABLE public.albums
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    artist_uuid UUID ,
+    title TEXT NOT NULL ,
+    type TEXT NOT NULL DEFAULT 'album' ,
+    release_date DATE ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (artist_uuid) REFERENCES artists(uuid) ON DELETE RESTRICT ,
+    CHECK (type IN ('album', 'single', 'ep', 'compilation'))
+);
+
+CREATE INDEX idx_albums_artist_uuid
+    ON public.albums (artist_uuid);
+
+CREATE TABLE public.album_tracks
+(
+    album_uuid UUID NOT NULL ,
+    audio_uuid UUID NOT NULL ,
+    position INT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    PRIMARY KEY (album_uuid, audio_uuid) ,
+    FOREIGN KEY (album_uuid) REFERENCES albums(uuid) ON DELETE CASCADE ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    -- deferred so tracks can be shifted by one statement
+    CONSTRAINT album_tracks_position_key UNIQUE (album_uuid, position) DEFERRABLE INITIALLY DEFERRED ,
+    CHECK (position > 0)
+);
+
+CREATE INDEX idx_album_tracks_audio_uuid
+    ON public.album_tracks (audio_uuid);
@@ -0,0 +1,2 @@
+DROP TABLE public.audio_credits;
+DROP TABLE public.persons;
@@ -0,0 +1,30 @@
+-- normalized_name is filled by application, see pkg/canon
+CREATE TABLE public.persons
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    name TEXT NOT NULL ,
+    normalized_name TEXT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_persons_normalized_name
+    ON public.persons (normalized_name);
+
+CREATE TABLE public.audio_credits
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    audio_uuid UUID NOT NULL ,
+    person_uuid UUID NOT NULL ,
+    role TEXT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    FOREIGN KEY (person_uuid) REFERENCES persons(uuid) ON DELETE RESTRICT ,
+    CHECK (role IN ('performer', 'featured', 'writer', 'composer', 'lyricist', 'producer'))
+);
+
+CREATE UNIQUE INDEX idx_audio_credits_audio_person_role
+    ON public.audio_credits (audio_uuid, person_uuid, role);
+
+CREATE INDEX idx_audio_credits_person_uuid
+    ON public.audio_credits (person_uuid);
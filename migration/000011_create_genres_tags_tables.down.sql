@@ -0,0 +1,4 @@
+DROP TABLE public.audio_tags;
+DROP TABLE public.tags;
+DROP TABLE public.audio_genres;
+DROP TABLE public.genres;
@@ -0,0 +1,53 @@
+CREATE TABLE public.genres
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    parent_uuid UUID ,
+    name TEXT NOT NULL ,
+    slug TEXT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (parent_uuid) REFERENCES genres(uuid) ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_genres_slug
+    ON public.genres (slug);
+
+CREATE INDEX idx_genres_parent_uuid
+    ON public.genres (parent_uuid);
+
+CREATE TABLE public.audio_genres
+(
+    audio_uuid UUID NOT NULL ,
+    genre_uuid UUID NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    PRIMARY KEY (audio_uuid, genre_uuid) ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    FOREIGN KEY (genre_uuid) REFERENCES genres(uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_audio_genres_genre_uuid
+    ON public.audio_genres (genre_uuid);
+
+-- name is stored canonical, see pkg/canon
+CREATE TABLE public.tags
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    name TEXT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_tags_name
+    ON public.tags (name);
+
+CREATE TABLE public.audio_tags
+(
+    audio_uuid UUID NOT NULL ,
+    tag_uuid UUID NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    PRIMARY KEY (audio_uuid, tag_uuid) ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    FOREIGN KEY (tag_uuid) REFERENCES tags(uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_audio_tags_tag_uuid
+    ON public.audio_tags (tag_uuid);
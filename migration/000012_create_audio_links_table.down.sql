@@ -0,0 +1 @@
+DROP TABLE public.audio_links;
@@ -0,0 +1,23 @@
+CREATE TABLE public.audio_links
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    audio_uuid UUID NOT NULL ,
+    platform TEXT NOT NULL ,
+    url TEXT NOT NULL ,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    CHECK (platform IN ('youtube', 'spotify', 'apple', 'yandex', 'soundcloud', 'other'))
+);
+
+CREATE UNIQUE INDEX idx_audio_links_audio_url
+    ON public.audio_links (audio_uuid, url);
+
+-- audios.link is a copy of the primary link url
+CREATE UNIQUE INDEX idx_audio_links_primary
+    ON public.audio_links (audio_uuid) WHERE is_primary;
+
+CREATE INDEX idx_audio_links_platform
+    ON public.audio_links (platform);
+
+-- links of existing audios are created on startup by pkg/links
@@ -0,0 +1 @@
+ALTER TABLE public.audio_links DROP COLUMN external_id;
@@ -0,0 +1,6 @@
+-- NULL until url is canonicalized on startup, empty if platform has no id
+ALTER TABLE public.audio_links
+    ADD COLUMN external_id TEXT;
+
+CREATE INDEX idx_audio_links_external_id
+    ON public.audio_links (platform, external_id) WHERE external_id <> '';
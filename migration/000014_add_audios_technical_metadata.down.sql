@@ -0,0 +1,6 @@
+ALTER TABLE public.audios
+    DROP COLUMN duration_ms,
+    DROP COLUMN isrc,
+    DROP COLUMN bpm,
+    DROP COLUMN musical_key,
+    DROP COLUMN explicit;
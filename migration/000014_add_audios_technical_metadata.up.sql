@@ -0,0 +1,12 @@
+ALTER TABLE public.audios
+    ADD COLUMN duration_ms INTEGER CHECK (duration_ms > 0) ,
+    ADD COLUMN isrc TEXT CHECK (isrc ~ '^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$') ,
+    ADD COLUMN bpm DOUBLE PRECISION CHECK (bpm > 0 AND bpm < 1000) ,
+    ADD COLUMN musical_key TEXT CHECK (musical_key ~ '^[A-G][#b]? (major|minor)$') ,
+    ADD COLUMN explicit BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_audios_isrc
+    ON public.audios (isrc);
+
+CREATE INDEX idx_audios_duration_ms
+    ON public.audios (duration_ms);
@@ -0,0 +1,3 @@
+ALTER TABLE public.info_cache DROP COLUMN release_date_precision;
+
+ALTER TABLE public.audios DROP COLUMN release_date_precision;
@@ -0,0 +1,7 @@
+-- release_date is the first day of year or month for coarser precision
+ALTER TABLE public.audios
+    ADD COLUMN release_date_precision TEXT NOT NULL DEFAULT 'day' ,
+    ADD CHECK (release_date_precision IN ('year', 'month', 'day'));
+
+ALTER TABLE public.info_cache
+    ADD COLUMN release_date_precision TEXT NOT NULL DEFAULT 'day';
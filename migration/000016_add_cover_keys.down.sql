@@ -0,0 +1,3 @@
+ALTER TABLE public.albums DROP COLUMN cover_key;
+
+ALTER TABLE public.audios DROP COLUMN cover_key;
@@ -0,0 +1,6 @@
+-- blob key of original cover image, thumbnails are stored next to it
+ALTER TABLE public.audios
+    ADD COLUMN cover_key TEXT;
+
+ALTER TABLE public.albums
+    ADD COLUMN cover_key TEXT;
@@ -0,0 +1,12 @@
+-- uploaded audio file, one per audio. Blob is stored in blob store
+CREATE TABLE public.audio_files
+(
+    audio_uuid UUID NOT NULL PRIMARY KEY ,
+    blob_key TEXT NOT NULL ,
+    format TEXT NOT NULL CHECK (format IN ('mp3', 'flac', 'ogg')) ,
+    size BIGINT NOT NULL ,
+    sha256 TEXT NOT NULL ,
+    duration_ms INTEGER ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP TABLE public.playlist_entries;
+DROP TABLE public.playlists;
@@ -0,0 +1,31 @@
+-- owner is user id from X-User-ID header
+CREATE TABLE public.playlists
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    owner TEXT NOT NULL ,
+    name TEXT NOT NULL ,
+    description TEXT NOT NULL DEFAULT '' ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL
+);
+
+CREATE INDEX idx_playlists_owner
+    ON public.playlists (owner);
+
+-- entries have own uuid as the same audio may be added several times
+CREATE TABLE public.playlist_entries
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    playlist_uuid UUID NOT NULL ,
+    audio_uuid UUID NOT NULL ,
+    position INT NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (playlist_uuid) REFERENCES playlists(uuid) ON DELETE CASCADE ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    -- deferred so entries can be shifted by one statement
+    CONSTRAINT playlist_entries_position_key UNIQUE (playlist_uuid, position) DEFERRABLE INITIALLY DEFERRED ,
+    CHECK (position > 0)
+);
+
+CREATE INDEX idx_playlist_entries_audio_uuid
+    ON public.playlist_entries (audio_uuid);
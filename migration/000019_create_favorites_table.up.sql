@@ -0,0 +1,15 @@
+-- user_id is user id from X-User-ID header
+CREATE TABLE public.favorites
+(
+    user_id TEXT NOT NULL ,
+    audio_uuid UUID NOT NULL ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    PRIMARY KEY (user_id, audio_uuid) ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_favorites_user_id_created_at
+    ON public.favorites (user_id, created_at DESC);
+
+CREATE INDEX idx_favorites_audio_uuid
+    ON public.favorites (audio_uuid);
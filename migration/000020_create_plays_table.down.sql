@@ -0,0 +1,3 @@
+ALTER TABLE public.audios DROP COLUMN play_count;
+
+DROP TABLE public.plays;
@@ -0,0 +1,22 @@
+-- listens of audios, user_id is user id from X-User-ID header
+CREATE TABLE public.plays
+(
+    uuid  UUID NOT NULL PRIMARY KEY DEFAULT GEN_RANDOM_UUID() ,
+    user_id TEXT NOT NULL ,
+    audio_uuid UUID NOT NULL ,
+    played_at timestamptz NOT NULL ,
+    duration_ms INTEGER ,
+    created_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    FOREIGN KEY (audio_uuid) REFERENCES audios(uuid) ON DELETE CASCADE ,
+    CHECK (duration_ms >= 0)
+);
+
+CREATE INDEX idx_plays_user_id_played_at
+    ON public.plays (user_id, played_at DESC);
+
+CREATE INDEX idx_plays_audio_uuid_played_at
+    ON public.plays (audio_uuid, played_at);
+
+-- aggregated count of plays, kept by play insert
+ALTER TABLE public.audios
+    ADD COLUMN play_count BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW public.chart_entries;
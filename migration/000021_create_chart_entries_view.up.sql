@@ -0,0 +1,51 @@
+-- chart standings rolled up from plays, refreshed periodically by app.
+-- kind is audio or group (artist). Previous standings are of the window
+-- of the same length before current one, all-time ones are as of a week ago
+CREATE MATERIALIZED VIEW public.chart_entries AS
+WITH periods (period, span, previous_end) AS (
+    VALUES ('week', INTERVAL '7 days', INTERVAL '7 days'),
+           ('month', INTERVAL '30 days', INTERVAL '30 days'),
+           ('all', NULL::INTERVAL, INTERVAL '7 days')
+),
+subject_plays (kind, subject_uuid, played_at) AS (
+    SELECT 'audio', p.audio_uuid, p.played_at
+    FROM public.plays p
+    UNION ALL
+    SELECT 'group', a.artist_uuid, p.played_at
+    FROM public.plays p
+    JOIN public.audios a ON a.uuid = p.audio_uuid
+),
+counts AS (
+    SELECT pr.period, sp.kind, sp.subject_uuid,
+           COUNT(*) FILTER (
+               WHERE pr.span IS NULL OR sp.played_at > now() - pr.span
+           ) AS plays,
+           COUNT(*) FILTER (
+               WHERE sp.played_at <= now() - pr.previous_end
+                 AND (pr.span IS NULL OR sp.played_at > now() - pr.previous_end - pr.span)
+           ) AS previous_plays
+    FROM periods pr
+    CROSS JOIN subject_plays sp
+    WHERE pr.span IS NULL OR sp.played_at > now() - pr.previous_end - pr.span
+    GROUP BY pr.period, sp.kind, sp.subject_uuid
+),
+ranked AS (
+    -- subjects without plays rank last, so they do not shift positions
+    SELECT period, kind, subject_uuid, plays,
+           RANK() OVER (PARTITION BY period, kind ORDER BY plays DESC) AS position,
+           CASE WHEN previous_plays > 0
+               THEN RANK() OVER (PARTITION BY period, kind ORDER BY previous_plays DESC)
+           END AS previous_position
+    FROM counts
+)
+SELECT period, kind, subject_uuid, plays, position, previous_position,
+       CURRENT_TIMESTAMP(3) AS refreshed_at
+FROM ranked
+WHERE plays > 0;
+
+-- required by REFRESH MATERIALIZED VIEW CONCURRENTLY
+CREATE UNIQUE INDEX idx_chart_entries_period_kind_subject_uuid
+    ON public.chart_entries (period, kind, subject_uuid);
+
+CREATE INDEX idx_chart_entries_period_kind_position
+    ON public.chart_entries (period, kind, position);
@@ -0,0 +1,3 @@
+DELETE FROM public.audio_provenance WHERE field = 'explicit';
+
+ALTER TABLE public.lyrics DROP COLUMN explicit;
@@ -0,0 +1,12 @@
+-- verse matched by profanity classifier, NULL until classified.
+-- Verses stored before classifier are classified on app start
+ALTER TABLE public.lyrics
+    ADD COLUMN explicit BOOLEAN;
+
+-- explicit flags set before classifier were set by hand,
+-- classifier must never clear them
+INSERT INTO public.audio_provenance (audio_uuid, field, source, updated_at)
+SELECT uuid, 'explicit', 'manual', updated_at
+FROM public.audios
+WHERE explicit
+ON CONFLICT (audio_uuid, field) DO NOTHING;
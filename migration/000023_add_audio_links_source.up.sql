@@ -0,0 +1,8 @@
+-- where link came from, provenance of audios.link follows its primary link
+ALTER TABLE public.audio_links
+    ADD COLUMN source TEXT NOT NULL DEFAULT 'manual';
+
+UPDATE public.audio_links li
+SET source = p.source
+FROM public.audio_provenance p
+WHERE p.audio_uuid = li.audio_uuid AND p.field = 'link' AND li.is_primary;
@@ -0,0 +1,9 @@
+-- single row with fingerprint of word lists verses were classified with,
+-- verses are classified again on app start when lists change
+CREATE TABLE public.lyrics_classifier
+(
+    id BOOLEAN NOT NULL PRIMARY KEY DEFAULT TRUE ,
+    fingerprint TEXT NOT NULL ,
+    updated_at timestamptz DEFAULT CURRENT_TIMESTAMP(3) NOT NULL ,
+    CHECK (id)
+);
@@ -0,0 +1,262 @@
+// Package fakeinfo implements fake song info service for local runs
+// and in-process tests.
+//
+// Responses are built from fixtures keyed by group and song. Behaviour
+// of each request is selected by scenario in X-Mock-Scenario header,
+// comma separated:
+//
+//	ok              fixture or 404 if not found (default)
+//	not-found       404
+//	bad-request     400
+//	fail            500
+//	malformed       200 with invalid JSON body
+//	timeout         no response until client cancels request
+//	latency=<dur>   wait before response, e.g. latency=300ms
+//	random          random 200, 400 or 500
+package fakeinfo
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ScenarioHeader
+// request header selecting scenario
+const ScenarioHeader = "X-Mock-Scenario"
+
+// Fixture
+// info service response for group and song
+type Fixture struct {
+	Group       string `json:"group"`
+	Song        string `json:"song"`
+	ReleaseDate string `json:"releaseDate"`
+	Text        string `json:"text"`
+	Link        string `json:"link"`
+}
+
+// RequestRecord
+// logged info request
+type RequestRecord struct {
+	Time     time.Time `json:"time"`
+	Method   string    `json:"method"`
+	Path     string    `json:"path"`
+	Group    string    `json:"group"`
+	Song     string    `json:"song"`
+	Scenario string    `json:"scenario"`
+	Status   int       `json:"status"`
+}
+
+type response struct {
+	ReleaseDate string `json:"releaseDate"`
+	Text        string `json:"text"`
+	Link        string `json:"link"`
+}
+
+// Server
+// fake info service, safe for concurrent use
+type Server struct {
+	mu       sync.Mutex
+	fixtures map[string]Fixture
+	requests []RequestRecord
+	scenario string
+	mux      *http.ServeMux
+}
+
+// New
+// return server with given fixtures
+func New(fixtures ...Fixture) *Server {
+	s := &Server{
+		fixtures: make(map[string]Fixture, len(fixtures)),
+		mux:      http.NewServeMux(),
+	}
+	for _, f := range fixtures {
+		s.AddFixture(f)
+	}
+
+	s.mux.HandleFunc("GET /info", s.handleInfo)
+	s.mux.HandleFunc("GET /requests", s.handleRequests)
+	s.mux.HandleFunc("DELETE /requests", s.handleRequestsReset)
+	return s
+}
+
+func key(group, song string) string {
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	}
+	return normalize(group) + "\n" + normalize(song)
+}
+
+// AddFixture
+// add or replace fixture
+func (s *Server) AddFixture(f Fixture) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[key(f.Group, f.Song)] = f
+}
+
+// LoadFixtures
+// add fixtures from all *.json files in dir. File holds
+// one Fixture object or array of them
+func (s *Server) LoadFixtures(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var fixtures []Fixture
+		if err = json.Unmarshal(data, &fixtures); err != nil {
+			f := Fixture{}
+			if err = json.Unmarshal(data, &f); err != nil {
+				return errors.New(path + ": " + err.Error())
+			}
+			fixtures = append(fixtures, f)
+		}
+		for _, f := range fixtures {
+			s.AddFixture(f)
+		}
+	}
+	return nil
+}
+
+// SetScenario
+// set scenario used when request has no scenario header
+func (s *Server) SetScenario(scenario string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scenario = scenario
+}
+
+// Requests
+// return copy of info requests log
+func (s *Server) Requests() []RequestRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RequestRecord{}, s.requests...)
+}
+
+// Reset
+// clear info requests log
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleInfo(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	rec := RequestRecord{
+		Time:     time.Now(),
+		Method:   r.Method,
+		Path:     r.URL.Path,
+		Group:    q.Get("group"),
+		Song:     q.Get("song"),
+		Scenario: r.Header.Get(ScenarioHeader),
+	}
+
+	// request is logged before response, status is set after
+	s.mu.Lock()
+	if rec.Scenario == "" {
+		rec.Scenario = s.scenario
+	}
+	fixture, found := s.fixtures[key(rec.Group, rec.Song)]
+	s.requests = append(s.requests, rec)
+	i := len(s.requests) - 1
+	s.mu.Unlock()
+
+	status := s.respond(w, r, rec.Scenario, fixture, found)
+
+	s.mu.Lock()
+	if i < len(s.requests) {
+		s.requests[i].Status = status
+	}
+	s.mu.Unlock()
+}
+
+// respond
+// write response by scenario and return status code
+func (s *Server) respond(w http.ResponseWriter, r *http.Request, scenario string, f Fixture, found bool) int {
+	status := http.StatusOK
+	malformed := false
+
+	for _, step := range strings.Split(scenario, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(step), "=")
+		switch name {
+		case "", "ok":
+		case "not-found":
+			found = false
+		case "bad-request":
+			status = http.StatusBadRequest
+		case "fail":
+			status = http.StatusInternalServerError
+		case "malformed":
+			malformed = true
+		case "timeout":
+			<-r.Context().Done()
+			return http.StatusGatewayTimeout
+		case "latency":
+			d, err := time.ParseDuration(arg)
+			if err != nil {
+				http.Error(w, "invalid latency: "+err.Error(), http.StatusBadRequest)
+				return http.StatusBadRequest
+			}
+			select {
+			case <-time.After(d):
+			case <-r.Context().Done():
+				return http.StatusGatewayTimeout
+			}
+		case "random":
+			status = []int{http.StatusOK, http.StatusBadRequest, http.StatusInternalServerError}[rand.IntN(3)]
+		default:
+			http.Error(w, "unknown scenario: "+name, http.StatusBadRequest)
+			return http.StatusBadRequest
+		}
+	}
+
+	if status == http.StatusOK && !found {
+		status = http.StatusNotFound
+	}
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+		return status
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if malformed {
+		w.Write([]byte(`{"releaseDate": "` + f.ReleaseDate + `", "text": `))
+		return status
+	}
+	json.NewEncoder(w).Encode(response{
+		ReleaseDate: f.ReleaseDate,
+		Text:        f.Text,
+		Link:        f.Link,
+	})
+	return status
+}
+
+func (s *Server) handleRequests(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.Requests())
+}
+
+func (s *Server) handleRequestsReset(w http.ResponseWriter, r *http.Request) {
+	s.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
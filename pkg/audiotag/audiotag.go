@@ -0,0 +1,176 @@
+package audiotag
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Audio formats detected by Read
+const (
+	FormatMP3  = "mp3"
+	FormatFLAC = "flac"
+	FormatOGG  = "ogg"
+)
+
+// maxTagSize
+// larger tag blocks, usually holding embedded pictures,
+// are skipped instead of read into memory
+const maxTagSize = 16 << 20
+
+var (
+	ErrUnsupportedFormat = errors.New("audio must be mp3, flac or ogg")
+	ErrMalformed         = errors.New("malformed audio file")
+)
+
+// Tags
+// metadata of audio file, missing values are zero
+type Tags struct {
+	Format      string
+	Title       string
+	Artist      string
+	Album       string
+	Year        int
+	TrackNumber int
+	Lyrics      string
+	Duration    time.Duration
+}
+
+// fill
+// set values missing in t from other
+func (t *Tags) fill(other *Tags) {
+	if t.Title == "" {
+		t.Title = other.Title
+	}
+	if t.Artist == "" {
+		t.Artist = other.Artist
+	}
+	if t.Album == "" {
+		t.Album = other.Album
+	}
+	if t.Year == 0 {
+		t.Year = other.Year
+	}
+	if t.TrackNumber == 0 {
+		t.TrackNumber = other.TrackNumber
+	}
+	if t.Lyrics == "" {
+		t.Lyrics = other.Lyrics
+	}
+	if t.Duration == 0 {
+		t.Duration = other.Duration
+	}
+}
+
+// samplesDuration
+// return duration of samples played at rate per second. Whole seconds
+// are divided out before scaling, so large counts do not overflow,
+// 0 if duration does not fit time.Duration
+func samplesDuration(samples, rate int64) time.Duration {
+	if samples <= 0 || rate <= 0 {
+		return 0
+	}
+	secs := samples / rate
+	if secs > int64(math.MaxInt64/time.Second)-1 {
+		return 0
+	}
+	return time.Duration(secs)*time.Second + time.Duration(samples%rate*int64(time.Second)/rate)
+}
+
+// Read
+// detect format of mp3, flac or ogg (vorbis, opus) file and read its
+// tags: ID3v1, ID3v2.2-2.4 and vorbis comments. Duration is computed
+// from stream headers
+func Read(r io.ReadSeeker) (*Tags, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	magic := make([]byte, 4)
+	if _, err = readAt(r, magic, 0); err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte("ID3")):
+		id3, audioStart, err := readID3v2(r)
+		if err != nil {
+			return nil, err
+		}
+		// flac files are sometimes prefixed with id3 tag
+		if _, err = readAt(r, magic, audioStart); err == nil && string(magic) == "fLaC" {
+			tags, err := readFLAC(r, audioStart)
+			if err != nil {
+				return nil, err
+			}
+			tags.fill(id3)
+			return tags, nil
+		}
+		return readMP3(r, size, audioStart, id3)
+	case string(magic) == "fLaC":
+		return readFLAC(r, 0)
+	case string(magic) == "OggS":
+		return readOGG(r, size)
+	case isFrameSync(magic):
+		return readMP3(r, size, 0, &Tags{})
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// readAt
+// read len(b) bytes at offset
+func readAt(r io.ReadSeeker, b []byte, offset int64) (int, error) {
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(r, b)
+}
+
+// readBlock
+// read n bytes from current position, n is bounded by maxTagSize
+func readBlock(r io.Reader, n int64) ([]byte, error) {
+	if n < 0 || n > maxTagSize {
+		return nil, ErrMalformed
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, ErrMalformed
+	}
+	return b, nil
+}
+
+// parseNumber
+// return leading number of values like "3/12", 0 if none
+func parseNumber(s string) int {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	n, _ := strconv.Atoi(s[:end])
+	return n
+}
+
+// parseYear
+// return year of dates like "2006", "2006-09-25", 0 if none
+func parseYear(s string) int {
+	s = strings.TrimSpace(s)
+	if len(s) < 4 {
+		return 0
+	}
+	for i := 0; i < 4; i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0
+		}
+	}
+	if len(s) > 4 && s[4] >= '0' && s[4] <= '9' {
+		return 0
+	}
+	year, _ := strconv.Atoi(s[:4])
+	return year
+}
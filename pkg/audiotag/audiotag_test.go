@@ -0,0 +1,181 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+// id3Frame
+// return ID3v2.3 frame with given body
+func id3Frame(id string, body []byte) []byte {
+	frame := append([]byte(id), 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(frame[4:8], uint32(len(body)))
+	return append(frame, body...)
+}
+
+// id3Tag
+// return ID3v2 tag of given version with frames
+func id3Tag(version byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	size := len(body)
+	header := []byte{'I', 'D', '3', version, 0, 0,
+		byte(size >> 21 & 0x7F), byte(size >> 14 & 0x7F), byte(size >> 7 & 0x7F), byte(size & 0x7F)}
+	return append(header, body...)
+}
+
+// mpegFrames
+// return n silent mpeg 1 layer III frames, 128 kbit/s, 44.1 kHz, stereo
+func mpegFrames(n int) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+	return bytes.Repeat(frame, n)
+}
+
+// vorbisComment
+// return vorbis comment structure with comments
+func vorbisComment(comments ...string) []byte {
+	b := binary.LittleEndian.AppendUint32(nil, 4)
+	b = append(b, "test"...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(comments)))
+	for _, c := range comments {
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(c)))
+		b = append(b, c...)
+	}
+	return b
+}
+
+// oggPageBytes
+// return ogg page holding single packet
+func oggPageBytes(granule int64, packet []byte) []byte {
+	header := make([]byte, 27)
+	copy(header, "OggS")
+	binary.LittleEndian.PutUint64(header[6:14], uint64(granule))
+	binary.LittleEndian.PutUint32(header[14:18], 7)
+
+	segments := bytes.Repeat([]byte{255}, len(packet)/255)
+	segments = append(segments, byte(len(packet)%255))
+	header[26] = byte(len(segments))
+	return append(append(header, segments...), packet...)
+}
+
+func TestRead_mp3(t *testing.T) {
+	uslt := append([]byte{3, 'e', 'n', 'g', 0}, "Never gonna give you up\n\nnever gonna let you down"...)
+	// utf-16 title with bom
+	title := []byte{1, 0xFF, 0xFE, 'S', 0, 'o', 0, 'n', 0, 'g', 0}
+
+	file := append(id3Tag(3,
+		id3Frame("TIT2", title),
+		id3Frame("TPE1", []byte("\x03Rick Astley")),
+		id3Frame("TALB", []byte("\x00Whenever You Need Somebody")),
+		id3Frame("TYER", []byte("\x001987")),
+		id3Frame("TRCK", []byte("\x001/10")),
+		id3Frame("USLT", uslt),
+	), mpegFrames(1000)...)
+
+	tags, err := Read(bytes.NewReader(file))
+	assert.NoError(t, err)
+	assert.Equal(t, FormatMP3, tags.Format)
+	assert.Equal(t, "Song", tags.Title)
+	assert.Equal(t, "Rick Astley", tags.Artist)
+	assert.Equal(t, "Whenever You Need Somebody", tags.Album)
+	assert.Equal(t, 1987, tags.Year)
+	assert.Equal(t, 1, tags.TrackNumber)
+	assert.Equal(t, "Never gonna give you up\n\nnever gonna let you down", tags.Lyrics)
+	// 1000 frames of 417 bytes at 128 kbit/s
+	assert.InDelta(t, 26062*time.Millisecond, tags.Duration, float64(10*time.Millisecond))
+}
+
+func TestRead_mp3XingAndID3v1(t *testing.T) {
+	frames := mpegFrames(100)
+	copy(frames[36:], "Info")
+	binary.BigEndian.PutUint32(frames[40:], 1)
+	binary.BigEndian.PutUint32(frames[44:], 1000)
+
+	v1 := make([]byte, 128)
+	copy(v1, "TAG")
+	copy(v1[3:], "Title")
+	copy(v1[33:], "Artist")
+	copy(v1[93:], "2001")
+	v1[126] = 7
+
+	tags, err := Read(bytes.NewReader(append(frames, v1...)))
+	assert.NoError(t, err)
+	assert.Equal(t, "Title", tags.Title)
+	assert.Equal(t, "Artist", tags.Artist)
+	assert.Equal(t, 2001, tags.Year)
+	assert.Equal(t, 7, tags.TrackNumber)
+	// 1000 frames of 1152 samples at 44.1 kHz
+	assert.Equal(t, 26122448979*time.Nanosecond, tags.Duration)
+}
+
+func TestRead_flac(t *testing.T) {
+	streamInfo := make([]byte, 34)
+	// 44.1 kHz, 441000 samples
+	streamInfo[10], streamInfo[11], streamInfo[12] = 0x0A, 0xC4, 0x40
+	binary.BigEndian.PutUint32(streamInfo[14:18], 441000)
+	comment := vorbisComment("title=Song", "ARTIST=Band", "DATE=2006-09-25", "TRACKNUMBER=3/12", "UNSYNCEDLYRICS=la la")
+
+	file := []byte("fLaC")
+	file = append(file, flacStreamInfo, 0, 0, byte(len(streamInfo)))
+	file = append(file, streamInfo...)
+	file = append(file, 0x80|flacVorbisComment, 0, byte(len(comment)>>8), byte(len(comment)))
+	file = append(file, comment...)
+
+	tags, err := Read(bytes.NewReader(file))
+	assert.NoError(t, err)
+	assert.Equal(t, &Tags{
+		Format:      FormatFLAC,
+		Title:       "Song",
+		Artist:      "Band",
+		Year:        2006,
+		TrackNumber: 3,
+		Lyrics:      "la la",
+		Duration:    10 * time.Second,
+	}, tags)
+}
+
+func TestRead_ogg(t *testing.T) {
+	ident := make([]byte, 30)
+	copy(ident, "\x01vorbis")
+	binary.LittleEndian.PutUint32(ident[12:16], 48000)
+	comment := append([]byte("\x03vorbis"), vorbisComment("TITLE=Song", "ALBUMARTIST=Band", "ALBUM=Album")...)
+
+	file := oggPageBytes(0, ident)
+	file = append(file, oggPageBytes(0, comment)...)
+	file = append(file, oggPageBytes(48000*90, make([]byte, 300))...)
+
+	tags, err := Read(bytes.NewReader(file))
+	assert.NoError(t, err)
+	assert.Equal(t, &Tags{
+		Format:   FormatOGG,
+		Title:    "Song",
+		Artist:   "Band",
+		Album:    "Album",
+		Duration: 90 * time.Second,
+	}, tags)
+}
+
+func TestRead_unsupported(t *testing.T) {
+	for _, file := range [][]byte{
+		[]byte("RIFF....WAVE"),
+		[]byte("ID3"),
+		{},
+	} {
+		_, err := Read(bytes.NewReader(file))
+		assert.Error(t, err)
+	}
+	_, err := Read(bytes.NewReader([]byte("\x89PNG\r\n\x1a\n")))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestSamplesDuration(t *testing.T) {
+	assert.Equal(t, 1500*time.Millisecond, samplesDuration(72000, 48000))
+	// granule of three day long stream overflows when multiplied by 1e9 first
+	assert.Equal(t, 72*time.Hour, samplesDuration(48000*3600*72, 48000))
+	assert.Equal(t, time.Duration(0), samplesDuration(math.MaxInt64, 1))
+	assert.Equal(t, time.Duration(0), samplesDuration(100, 0))
+}
@@ -0,0 +1,136 @@
+package audiotag
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+// flac metadata block types
+const (
+	flacStreamInfo    = 0
+	flacVorbisComment = 4
+)
+
+// readFLAC
+// read STREAMINFO and VORBIS_COMMENT metadata blocks of flac stream
+// starting at offset
+func readFLAC(r io.ReadSeeker, offset int64) (*Tags, error) {
+	tags := &Tags{Format: FormatFLAC}
+	if _, err := r.Seek(offset+4, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 4)
+	for last := false; !last; {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, ErrMalformed
+		}
+		last = header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		length := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+
+		switch blockType {
+		case flacStreamInfo:
+			block, err := readBlock(r, length)
+			if err != nil || len(block) < 18 {
+				return nil, ErrMalformed
+			}
+			sampleRate := int64(block[10])<<12 | int64(block[11])<<4 | int64(block[12])>>4
+			samples := int64(block[13]&0x0F)<<32 | int64(binary.BigEndian.Uint32(block[14:18]))
+			tags.Duration = samplesDuration(samples, sampleRate)
+		case flacVorbisComment:
+			block, err := readBlock(r, length)
+			if err != nil {
+				// comments with embedded pictures may exceed maxTagSize
+				if length <= maxTagSize {
+					return nil, err
+				}
+				if _, err = r.Seek(length, io.SeekCurrent); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			parseVorbisComment(block, tags)
+		default:
+			if _, err := r.Seek(length, io.SeekCurrent); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return tags, nil
+}
+
+// parseVorbisComment
+// fill tags from vorbis comment structure: vendor string and list of
+// KEY=value strings, all lengths are little endian uint32
+func parseVorbisComment(b []byte, tags *Tags) {
+	next := func() (string, bool) {
+		if len(b) < 4 {
+			return "", false
+		}
+		n := binary.LittleEndian.Uint32(b)
+		if uint64(n) > uint64(len(b)-4) {
+			return "", false
+		}
+		s := string(b[4 : 4+n])
+		b = b[4+n:]
+		return s, true
+	}
+
+	if _, ok := next(); !ok {
+		return
+	}
+	if len(b) < 4 {
+		return
+	}
+	count := binary.LittleEndian.Uint32(b)
+	b = b[4:]
+
+	albumArtist := ""
+	for i := uint32(0); i < count; i++ {
+		comment, ok := next()
+		if !ok {
+			break
+		}
+		key, value, ok := strings.Cut(comment, "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		// first value of repeated field wins
+		switch strings.ToUpper(key) {
+		case "TITLE":
+			if tags.Title == "" {
+				tags.Title = value
+			}
+		case "ARTIST":
+			if tags.Artist == "" {
+				tags.Artist = value
+			}
+		case "ALBUMARTIST":
+			if albumArtist == "" {
+				albumArtist = value
+			}
+		case "ALBUM":
+			if tags.Album == "" {
+				tags.Album = value
+			}
+		case "DATE", "YEAR":
+			if tags.Year == 0 {
+				tags.Year = parseYear(value)
+			}
+		case "TRACKNUMBER":
+			if tags.TrackNumber == 0 {
+				tags.TrackNumber = parseNumber(value)
+			}
+		case "LYRICS", "UNSYNCEDLYRICS":
+			if tags.Lyrics == "" {
+				tags.Lyrics = value
+			}
+		}
+	}
+	if tags.Artist == "" {
+		tags.Artist = albumArtist
+	}
+}
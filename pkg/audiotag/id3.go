@@ -0,0 +1,280 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// id3v2 header flags
+const (
+	id3Unsync   = 0x80
+	id3Extended = 0x40
+	id3Footer   = 0x10
+)
+
+// id3Frames
+// frame ids of read fields per major version, v2.2 uses 3 letter ids
+var id3Frames = map[byte]map[string]string{
+	2: {"TT2": "title", "TP1": "artist", "TAL": "album", "TYE": "year", "TRK": "track", "ULT": "lyrics", "TLE": "length"},
+	3: {"TIT2": "title", "TPE1": "artist", "TALB": "album", "TYER": "year", "TRCK": "track", "USLT": "lyrics", "TLEN": "length"},
+	4: {"TIT2": "title", "TPE1": "artist", "TALB": "album", "TDRC": "year", "TRCK": "track", "USLT": "lyrics", "TLEN": "length"},
+}
+
+// readID3v2
+// read ID3v2 tag at file start, return tags and offset of audio data.
+// Tags larger than maxTagSize are skipped
+func readID3v2(r io.ReadSeeker) (*Tags, int64, error) {
+	header := make([]byte, 10)
+	if _, err := readAt(r, header, 0); err != nil {
+		return nil, 0, ErrMalformed
+	}
+	version, flags := header[3], header[5]
+	size, ok := synchsafe(header[6:10])
+	if !ok {
+		return nil, 0, ErrMalformed
+	}
+	audioStart := 10 + size
+	if version == 4 && flags&id3Footer != 0 {
+		audioStart += 10
+	}
+
+	tags := &Tags{}
+	if version < 2 || version > 4 || size > maxTagSize {
+		return tags, audioStart, nil
+	}
+	data, err := readBlock(r, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	if flags&id3Unsync != 0 && version < 4 {
+		data = removeUnsync(data)
+	}
+	if flags&id3Extended != 0 && version > 2 {
+		data = skipExtendedHeader(data, version)
+	}
+
+	parseID3Frames(data, version, tags)
+	return tags, audioStart, nil
+}
+
+// parseID3Frames
+// fill tags from frames of tag body
+func parseID3Frames(data []byte, version byte, tags *Tags) {
+	idLen, headerLen := 4, 10
+	if version == 2 {
+		idLen, headerLen = 3, 6
+	}
+	frames := id3Frames[version]
+
+	for len(data) >= headerLen && data[0] != 0 {
+		id := string(data[:idLen])
+		var size int64
+		var formatFlags byte
+		skip := false
+		switch version {
+		case 2:
+			size = int64(data[3])<<16 | int64(data[4])<<8 | int64(data[5])
+		case 3:
+			size = int64(binary.BigEndian.Uint32(data[4:8]))
+			// compressed or encrypted
+			skip = data[9]&0xC0 != 0
+		case 4:
+			var ok bool
+			size, ok = synchsafe(data[4:8])
+			if !ok {
+				return
+			}
+			formatFlags = data[9]
+			// compressed or encrypted
+			skip = formatFlags&0x0C != 0
+		}
+		if size > int64(len(data)-headerLen) {
+			return
+		}
+		body := data[headerLen : headerLen+int(size)]
+		data = data[headerLen+int(size):]
+
+		field, ok := frames[id]
+		if !ok || skip {
+			continue
+		}
+		if version == 4 {
+			// data length indicator
+			if formatFlags&0x01 != 0 {
+				if len(body) < 4 {
+					continue
+				}
+				body = body[4:]
+			}
+			if formatFlags&0x02 != 0 {
+				body = removeUnsync(body)
+			}
+		}
+		setID3Field(tags, field, body)
+	}
+}
+
+// setID3Field
+// decode frame body and set tag field, first value wins
+func setID3Field(tags *Tags, field string, body []byte) {
+	if len(body) < 1 {
+		return
+	}
+	if field == "lyrics" {
+		// encoding, language, content descriptor, text
+		if len(body) < 4 || tags.Lyrics != "" {
+			return
+		}
+		_, text := splitTerminated(body[0], body[4:])
+		tags.Lyrics = strings.TrimSpace(decodeID3Text(body[0], text))
+		return
+	}
+
+	value := decodeID3Text(body[0], body[1:])
+	// v2.4 frames may hold several null separated values
+	value, _, _ = strings.Cut(value, "\x00")
+	value = strings.TrimSpace(value)
+	switch field {
+	case "title":
+		tags.Title = value
+	case "artist":
+		tags.Artist = value
+	case "album":
+		tags.Album = value
+	case "year":
+		tags.Year = parseYear(value)
+	case "track":
+		tags.TrackNumber = parseNumber(value)
+	case "length":
+		ms, err := strconv.Atoi(value)
+		if err == nil && ms > 0 {
+			tags.Duration = time.Duration(ms) * time.Millisecond
+		}
+	}
+}
+
+// decodeID3Text
+// decode text of given ID3 encoding: latin1, utf-16 with bom, utf-16be, utf-8
+func decodeID3Text(encoding byte, b []byte) string {
+	switch encoding {
+	case 0:
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		return strings.TrimRight(string(runes), "\x00")
+	case 1, 2:
+		bigEndian := encoding == 2
+		if len(b) >= 2 && b[0] == 0xFF && b[1] == 0xFE {
+			bigEndian, b = false, b[2:]
+		} else if len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF {
+			bigEndian, b = true, b[2:]
+		}
+		units := make([]uint16, 0, len(b)/2)
+		for i := 0; i+1 < len(b); i += 2 {
+			if bigEndian {
+				units = append(units, binary.BigEndian.Uint16(b[i:]))
+			} else {
+				units = append(units, binary.LittleEndian.Uint16(b[i:]))
+			}
+		}
+		return strings.TrimRight(string(utf16.Decode(units)), "\x00")
+	default:
+		return strings.TrimRight(string(b), "\x00")
+	}
+}
+
+// splitTerminated
+// split null terminated string of given encoding from the rest of b
+func splitTerminated(encoding byte, b []byte) ([]byte, []byte) {
+	if encoding == 1 || encoding == 2 {
+		for i := 0; i+1 < len(b); i += 2 {
+			if b[i] == 0 && b[i+1] == 0 {
+				return b[:i], b[i+2:]
+			}
+		}
+		return b, nil
+	}
+	i := bytes.IndexByte(b, 0)
+	if i < 0 {
+		return b, nil
+	}
+	return b[:i], b[i+1:]
+}
+
+// synchsafe
+// decode 28 bit integer stored in 4 bytes with cleared high bits
+func synchsafe(b []byte) (int64, bool) {
+	var n int64
+	for _, c := range b {
+		if c&0x80 != 0 {
+			return 0, false
+		}
+		n = n<<7 | int64(c)
+	}
+	return n, true
+}
+
+// removeUnsync
+// revert unsynchronisation: 0xFF 0x00 -> 0xFF
+func removeUnsync(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		out = append(out, b[i])
+		if b[i] == 0xFF && i+1 < len(b) && b[i+1] == 0 {
+			i++
+		}
+	}
+	return out
+}
+
+// skipExtendedHeader
+// return tag body without extended header
+func skipExtendedHeader(data []byte, version byte) []byte {
+	if len(data) < 4 {
+		return nil
+	}
+	var size int64
+	if version == 3 {
+		// size excludes size bytes
+		size = int64(binary.BigEndian.Uint32(data)) + 4
+	} else {
+		size, _ = synchsafe(data[:4])
+	}
+	if size < 4 || size > int64(len(data)) {
+		return nil
+	}
+	return data[size:]
+}
+
+// readID3v1
+// read ID3v1 tag at the last 128 bytes of file, nil if absent
+func readID3v1(r io.ReadSeeker, size int64) *Tags {
+	if size < 128 {
+		return nil
+	}
+	b := make([]byte, 128)
+	if _, err := readAt(r, b, size-128); err != nil || string(b[:3]) != "TAG" {
+		return nil
+	}
+
+	field := func(b []byte) string {
+		return strings.TrimSpace(decodeID3Text(0, bytes.TrimRight(b, "\x00")))
+	}
+	tags := &Tags{
+		Title:  field(b[3:33]),
+		Artist: field(b[33:63]),
+		Album:  field(b[63:93]),
+		Year:   parseYear(string(b[93:97])),
+	}
+	// ID3v1.1 stores track in last comment byte
+	if b[125] == 0 && b[126] != 0 {
+		tags.TrackNumber = int(b[126])
+	}
+	return tags
+}
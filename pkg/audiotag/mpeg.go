@@ -0,0 +1,182 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// maxSyncSearch
+// bytes after tag searched for first mpeg frame
+const maxSyncSearch = 64 << 10
+
+// mpeg versions
+const (
+	mpeg25 = 0
+	mpeg2  = 2
+	mpeg1  = 3
+)
+
+// bitrates in kbit/s by [mpeg1][layer-1][index], layer index is 0 for layer I
+var bitrates = [2][3][16]int{
+	{ // mpeg 2, 2.5
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+	},
+	{ // mpeg 1
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+	},
+}
+
+var sampleRates = [4][3]int{
+	mpeg25: {11025, 12000, 8000},
+	mpeg2:  {22050, 24000, 16000},
+	mpeg1:  {44100, 48000, 32000},
+}
+
+// frameHeader
+// decoded mpeg audio frame header
+type frameHeader struct {
+	version         int
+	layer           int
+	bitrate         int // bit/s
+	sampleRate      int
+	samplesPerFrame int
+	length          int
+	mono            bool
+}
+
+func isFrameSync(b []byte) bool {
+	return len(b) >= 2 && b[0] == 0xFF && b[1]&0xE0 == 0xE0
+}
+
+// parseFrameHeader
+// decode 4 byte frame header, false if invalid or free format
+func parseFrameHeader(b []byte) (frameHeader, bool) {
+	if len(b) < 4 || !isFrameSync(b) {
+		return frameHeader{}, false
+	}
+	version := int(b[1]>>3) & 3
+	layer := 4 - int(b[1]>>1)&3
+	bitrateIdx := int(b[2] >> 4)
+	rateIdx := int(b[2]>>2) & 3
+	padding := int(b[2]>>1) & 1
+	if version == 1 || layer == 4 || rateIdx == 3 {
+		return frameHeader{}, false
+	}
+
+	isMPEG1 := 0
+	if version == mpeg1 {
+		isMPEG1 = 1
+	}
+	h := frameHeader{
+		version:    version,
+		layer:      layer,
+		bitrate:    bitrates[isMPEG1][layer-1][bitrateIdx] * 1000,
+		sampleRate: sampleRates[version][rateIdx],
+		mono:       b[3]>>6 == 3,
+	}
+	if h.bitrate == 0 {
+		return frameHeader{}, false
+	}
+
+	switch {
+	case layer == 1:
+		h.samplesPerFrame = 384
+		h.length = (12*h.bitrate/h.sampleRate + padding) * 4
+	case layer == 3 && version != mpeg1:
+		h.samplesPerFrame = 576
+		h.length = 72*h.bitrate/h.sampleRate + padding
+	default:
+		h.samplesPerFrame = 1152
+		h.length = 144*h.bitrate/h.sampleRate + padding
+	}
+	return h, true
+}
+
+// readMP3
+// compute duration of mpeg audio stream starting at audioStart,
+// fill blank tags from ID3v1
+func readMP3(r io.ReadSeeker, size, audioStart int64, tags *Tags) (*Tags, error) {
+	tags.Format = FormatMP3
+	audioEnd := size
+	if v1 := readID3v1(r, size); v1 != nil {
+		tags.fill(v1)
+		audioEnd -= 128
+	}
+
+	buf := make([]byte, min(maxSyncSearch, max(audioEnd-audioStart, 0)))
+	n, _ := readAt(r, buf, audioStart)
+	buf = buf[:n]
+
+	offset, h, ok := findFrame(buf)
+	if !ok {
+		return nil, ErrUnsupportedFormat
+	}
+	if duration, ok := vbrDuration(buf[offset:], h); ok {
+		tags.Duration = duration
+		return tags, nil
+	}
+
+	streamBytes := audioEnd - audioStart - int64(offset)
+	tags.Duration = samplesDuration(streamBytes*8, int64(h.bitrate))
+	return tags, nil
+}
+
+// findFrame
+// return offset of first frame followed by another valid frame
+func findFrame(buf []byte) (int, frameHeader, bool) {
+	for i := 0; i+4 <= len(buf); i++ {
+		h, ok := parseFrameHeader(buf[i:])
+		if !ok {
+			continue
+		}
+		next := i + h.length
+		if next+4 > len(buf) {
+			// stream shorter than two frames
+			return i, h, next == len(buf)
+		}
+		if nh, ok := parseFrameHeader(buf[next:]); ok && nh.version == h.version && nh.layer == h.layer {
+			return i, h, true
+		}
+	}
+	return 0, frameHeader{}, false
+}
+
+// vbrDuration
+// return duration from Xing/Info or VBRI header of first frame
+func vbrDuration(frame []byte, h frameHeader) (time.Duration, bool) {
+	sideInfo := 32
+	switch {
+	case h.version == mpeg1 && h.mono:
+		sideInfo = 17
+	case h.version != mpeg1 && h.mono:
+		sideInfo = 9
+	case h.version != mpeg1:
+		sideInfo = 17
+	}
+
+	var frames uint32
+	if x := 4 + sideInfo; len(frame) >= x+12 &&
+		(bytes.Equal(frame[x:x+4], []byte("Xing")) || bytes.Equal(frame[x:x+4], []byte("Info"))) {
+		flags := binary.BigEndian.Uint32(frame[x+4:])
+		if flags&1 == 0 {
+			return 0, false
+		}
+		frames = binary.BigEndian.Uint32(frame[x+8:])
+	} else if v := 4 + 32; len(frame) >= v+18 && bytes.Equal(frame[v:v+4], []byte("VBRI")) {
+		frames = binary.BigEndian.Uint32(frame[v+14:])
+	} else {
+		return 0, false
+	}
+	if frames == 0 {
+		return 0, false
+	}
+
+	samples := int64(frames) * int64(h.samplesPerFrame)
+	return samplesDuration(samples, int64(h.sampleRate)), true
+}
@@ -0,0 +1,135 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// oggTailSize
+// bytes at file end searched for last page
+const oggTailSize = 64 << 10
+
+// opusGranuleRate
+// granule position of opus streams is always counted at 48 kHz
+const opusGranuleRate = 48000
+
+// oggPage
+// ogg page header fields used by reader
+type oggPage struct {
+	granule  int64
+	serial   uint32
+	segments []byte
+}
+
+// readOGGPage
+// read page header at current position
+func readOGGPage(r io.Reader) (oggPage, error) {
+	header := make([]byte, 27)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:4]) != "OggS" {
+		return oggPage{}, ErrMalformed
+	}
+	page := oggPage{
+		granule:  int64(binary.LittleEndian.Uint64(header[6:14])),
+		serial:   binary.LittleEndian.Uint32(header[14:18]),
+		segments: make([]byte, header[26]),
+	}
+	if _, err := io.ReadFull(r, page.segments); err != nil {
+		return oggPage{}, ErrMalformed
+	}
+	return page, nil
+}
+
+// readOGGHeaders
+// return identification and comment packets of first logical stream
+func readOGGHeaders(r io.ReadSeeker) (uint32, [][]byte, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, nil, err
+	}
+
+	var serial uint32
+	packets := make([][]byte, 0, 2)
+	packet := []byte{}
+	for first := true; len(packets) < 2; first = false {
+		page, err := readOGGPage(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		if first {
+			serial = page.serial
+		}
+		for _, segment := range page.segments {
+			data := make([]byte, segment)
+			if _, err = io.ReadFull(r, data); err != nil {
+				return 0, nil, ErrMalformed
+			}
+			// pages of other multiplexed streams are skipped
+			if page.serial != serial || len(packets) == 2 {
+				continue
+			}
+			packet = append(packet, data...)
+			if len(packet) > maxTagSize {
+				return 0, nil, ErrMalformed
+			}
+			if segment < 255 {
+				packets = append(packets, packet)
+				packet = []byte{}
+			}
+		}
+	}
+	return serial, packets, nil
+}
+
+// readOGG
+// read vorbis or opus stream headers, duration is granule position
+// of the last page
+func readOGG(r io.ReadSeeker, size int64) (*Tags, error) {
+	serial, packets, err := readOGGHeaders(r)
+	if err != nil {
+		return nil, err
+	}
+	ident, comment := packets[0], packets[1]
+
+	tags := &Tags{Format: FormatOGG}
+	var sampleRate, preSkip int64
+	switch {
+	case len(ident) >= 16 && bytes.HasPrefix(ident, []byte("\x01vorbis")):
+		sampleRate = int64(binary.LittleEndian.Uint32(ident[12:16]))
+		if !bytes.HasPrefix(comment, []byte("\x03vorbis")) {
+			return nil, ErrMalformed
+		}
+		parseVorbisComment(comment[7:], tags)
+	case len(ident) >= 12 && bytes.HasPrefix(ident, []byte("OpusHead")):
+		sampleRate = opusGranuleRate
+		preSkip = int64(binary.LittleEndian.Uint16(ident[10:12]))
+		if !bytes.HasPrefix(comment, []byte("OpusTags")) {
+			return nil, ErrMalformed
+		}
+		parseVorbisComment(comment[8:], tags)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	granule := lastGranule(r, size, serial)
+	if sampleRate > 0 && granule > preSkip {
+		tags.Duration = samplesDuration(granule-preSkip, sampleRate)
+	}
+	return tags, nil
+}
+
+// lastGranule
+// return granule position of the last page of stream, 0 if not found
+func lastGranule(r io.ReadSeeker, size int64, serial uint32) int64 {
+	start := max(size-oggTailSize, 0)
+	tail := make([]byte, size-start)
+	n, _ := readAt(r, tail, start)
+	tail = tail[:n]
+
+	for i := bytes.LastIndex(tail, []byte("OggS")); i >= 0; i = bytes.LastIndex(tail[:i], []byte("OggS")) {
+		page, err := readOGGPage(bytes.NewReader(tail[i:]))
+		if err == nil && page.serial == serial && page.granule > 0 {
+			return page.granule
+		}
+	}
+	return 0
+}
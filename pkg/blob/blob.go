@@ -0,0 +1,46 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Object
+// metadata of stored blob
+type Object struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// Store
+// storage of uploaded files addressed by slash separated keys
+type Store interface {
+	// Put write blob, existing blob with the same key is replaced
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Open return blob content, ErrNotFound if blob does not exist
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, *Object, error)
+	// Delete remove blob, missing blob is not an error
+	Delete(ctx context.Context, key string) error
+	// URL return public url of blob
+	URL(key string) string
+}
+
+// ValidKey
+// return true if key is relative slash separated path without
+// "." and ".." elements
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	return path.Clean(key) == key && !strings.HasPrefix(key, "../") && key != ".."
+}
@@ -0,0 +1,44 @@
+package blob
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// Handler
+// serve blobs of store with keys under one of prefixes, key is
+// request path without leading slash. Directories are never listed,
+// any other key is not found
+func Handler(store Store, prefixes ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/")
+		if !ValidKey(key) || !underPrefix(key, prefixes) {
+			http.NotFound(w, r)
+			return
+		}
+
+		content, obj, err := store.Open(r.Context(), key)
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidKey) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		defer content.Close()
+
+		http.ServeContent(w, r, path.Base(key), obj.ModTime, content)
+	})
+}
+
+func underPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
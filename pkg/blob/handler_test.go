@@ -0,0 +1,72 @@
+package blob
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocal(t.TempDir(), "/blob/")
+	assert.NoError(t, err)
+
+	_, err = s.Put(ctx, "covers/a/original.png", strings.NewReader("image"))
+	assert.NoError(t, err)
+	_, err = s.Put(ctx, "audio-files/a", strings.NewReader("audio"))
+	assert.NoError(t, err)
+
+	testTable := []struct {
+		name         string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "served_prefix",
+			path:         "/covers/a/original.png",
+			expectedCode: 200,
+			expectedBody: "image",
+		},
+		{
+			name:         "not_served_prefix",
+			path:         "/audio-files/a",
+			expectedCode: 404,
+		},
+		{
+			name:         "directory",
+			path:         "/covers/a/",
+			expectedCode: 404,
+		},
+		{
+			name:         "prefix_directory",
+			path:         "/covers",
+			expectedCode: 404,
+		},
+		{
+			name:         "missing",
+			path:         "/covers/b/original.png",
+			expectedCode: 404,
+		},
+		{
+			name:         "dot_dot",
+			path:         "/covers/../audio-files/a",
+			expectedCode: 404,
+		},
+	}
+
+	h := Handler(s, "covers")
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest("GET", testCase.path, nil))
+
+			assert.Equal(t, testCase.expectedCode, w.Code)
+			if testCase.expectedBody != "" {
+				assert.Equal(t, testCase.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
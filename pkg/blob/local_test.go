@@ -0,0 +1,45 @@
+package blob
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocal(t.TempDir(), "/blob/")
+	assert.NoError(t, err)
+
+	n, err := s.Put(ctx, "covers/a/original.png", strings.NewReader("image"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+
+	r, obj, err := s.Open(ctx, "covers/a/original.png")
+	assert.NoError(t, err)
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "image", string(data))
+	assert.Equal(t, int64(5), obj.Size)
+
+	assert.Equal(t, "/blob/covers/a/original.png", s.URL("covers/a/original.png"))
+
+	assert.NoError(t, s.Delete(ctx, "covers/a/original.png"))
+	assert.NoError(t, s.Delete(ctx, "covers/a/original.png"))
+	_, _, err = s.Open(ctx, "covers/a/original.png")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, _, err = s.Open(ctx, "covers/a")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestValidKey(t *testing.T) {
+	for _, key := range []string{"a", "covers/a/original.png"} {
+		assert.True(t, ValidKey(key), key)
+	}
+	for _, key := range []string{"", "/a", "../a", "a/../../b", "a/./b", "a//b", "a/", "..", `a\b`} {
+		assert.False(t, ValidKey(key), key)
+	}
+}
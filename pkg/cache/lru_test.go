@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLRU_Evict(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Set("a", 1, 0)
+	c.Set("b", 2, 0)
+
+	// touch "a" so "b" becomes least recently used
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	c.Set("c", 3, 0)
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	v, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestLRU_TTL(t *testing.T) {
+	now := time.Date(2024, 10, 5, 12, 0, 0, 0, time.UTC)
+	c := NewLRU[string, int](2)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1, time.Minute)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLRU_Overwrite(t *testing.T) {
+	c := NewLRU[string, *int](1)
+	c.Set("a", nil, 0)
+
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Nil(t, v)
+
+	one := 1
+	c.Set("a", &one, 0)
+	v, ok = c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, *v)
+}
@@ -0,0 +1,80 @@
+package canon
+
+import (
+	"strings"
+	"unicode"
+)
+
+// cyrillic
+// transliteration of lower case cyrillic letters
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// latin
+// latin letters with diacritics folded to base letter
+var latin = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i",
+	'î': "i", 'ï': "i", 'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o",
+	'ö': "o", 'ø': "o", 'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y",
+	'ÿ': "y", 'ß': "ss", 'œ': "oe",
+}
+
+// ArtistName
+// return canonical form of artist name used for matching: lower case,
+// transliterated to latin, without punctuation and leading "the".
+// "The Beatles", "beatles!" -> "beatles"; "Кино" -> "kino"
+func ArtistName(name string) string {
+	words := fold(name)
+	if len(words) > 1 && words[0] == "the" {
+		words = words[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// Slug
+// return url-safe form of name: folded like ArtistName but keeping
+// leading "the", words joined with "-". "Post-Punk" -> "post-punk"
+func Slug(name string) string {
+	return strings.Join(fold(name), "-")
+}
+
+// Tag
+// return canonical form of free-form tag: lower case with collapsed
+// whitespace, script is kept as is
+func Tag(tag string) string {
+	return strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+}
+
+// fold
+// split name into lower case latin words, punctuation separates words
+func fold(name string) []string {
+	b := strings.Builder{}
+	for _, r := range strings.ToLower(name) {
+		if t, ok := cyrillic[r]; ok {
+			b.WriteString(t)
+			continue
+		}
+		if t, ok := latin[r]; ok {
+			b.WriteString(t)
+			continue
+		}
+		switch {
+		case r == '&':
+			b.WriteString(" and ")
+		case r == '\'' || r == '’':
+			// "guns n' roses" -> "guns n roses"
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
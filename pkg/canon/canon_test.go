@@ -0,0 +1,56 @@
+package canon
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestArtistName(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected string
+	}{
+		{"The Beatles", "beatles"},
+		{"  beatles ", "beatles"},
+		{"BEATLES!", "beatles"},
+		{"The The", "the"},
+		{"Кино", "kino"},
+		{"КИНО", "kino"},
+		{"Ночные Снайперы", "nochnye snaypery"},
+		{"Beyoncé", "beyonce"},
+		{"Guns N' Roses", "guns n roses"},
+		{"Simon & Garfunkel", "simon and garfunkel"},
+		{"AC/DC", "ac dc"},
+		{"", ""},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.input, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, ArtistName(testCase.input))
+		})
+	}
+}
+
+func TestSlug(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected string
+	}{
+		{"Post-Punk", "post-punk"},
+		{" Rock & Roll ", "rock-and-roll"},
+		{"The Blues", "the-blues"},
+		{"Хип-хоп", "khip-khop"},
+		{"", ""},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.input, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, Slug(testCase.input))
+		})
+	}
+}
+
+func TestTag(t *testing.T) {
+	assert.Equal(t, "summer hits", Tag("  Summer   HITS "))
+	assert.Equal(t, "для бега", Tag("Для  Бега"))
+}
@@ -0,0 +1,212 @@
+package links
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidURL = errors.New("must be absolute http or https url")
+
+// Link platforms
+const (
+	PlatformYouTube    = "youtube"
+	PlatformSpotify    = "spotify"
+	PlatformApple      = "apple"
+	PlatformYandex     = "yandex"
+	PlatformSoundCloud = "soundcloud"
+	PlatformOther      = "other"
+)
+
+// platformHosts
+// hosts of each platform, subdomains match too
+var platformHosts = []struct {
+	platform string
+	hosts    []string
+}{
+	{PlatformYouTube, []string{"youtube.com", "youtu.be", "youtube-nocookie.com"}},
+	{PlatformSpotify, []string{"spotify.com", "spotify.link"}},
+	{PlatformApple, []string{"music.apple.com", "itunes.apple.com"}},
+	{PlatformYandex, []string{"music.yandex.ru", "music.yandex.com", "music.yandex.by", "music.yandex.kz"}},
+	{PlatformSoundCloud, []string{"soundcloud.com", "snd.sc"}},
+}
+
+// Platforms
+// return all known platforms including other
+func Platforms() []string {
+	platforms := make([]string, 0, len(platformHosts)+1)
+	for _, p := range platformHosts {
+		platforms = append(platforms, p.platform)
+	}
+	return append(platforms, PlatformOther)
+}
+
+// IsPlatform
+// return true if platform is one of known platforms
+func IsPlatform(platform string) bool {
+	for _, p := range Platforms() {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// Platform
+// detect platform by url host, "other" if unknown or url is invalid
+func Platform(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return PlatformOther
+	}
+	host := strings.ToLower(u.Hostname())
+
+	for _, p := range platformHosts {
+		for _, h := range p.hosts {
+			if host == h || strings.HasSuffix(host, "."+h) {
+				return p.platform
+			}
+		}
+	}
+	return PlatformOther
+}
+
+// Link
+// canonical form of url with detected platform and id of track
+// on the platform, id is empty if platform has no ids or url is not a track
+type Link struct {
+	URL        string
+	Platform   string
+	ExternalID string
+}
+
+var (
+	youTubeID = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	spotifyID = regexp.MustCompile(`^[A-Za-z0-9]{22}$`)
+	numericID = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// trackingParams
+// query params that do not change resource, utm_* params are dropped too
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "yclid": true, "si": true, "feature": true, "ref": true,
+}
+
+// Parse
+// validate url and return its canonical form. Scheme and host are lower
+// cased, default port, fragment and tracking params are dropped.
+// Urls of known platforms are reduced to canonical track url:
+// "https://youtu.be/dQw4w9WgXcQ?t=1" -> "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+func Parse(rawURL string) (Link, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return Link{}, ErrInvalidURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return Link{}, ErrInvalidURL
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+
+	query := url.Values{}
+	for key, values := range u.Query() {
+		if trackingParams[key] || strings.HasPrefix(key, "utm_") {
+			continue
+		}
+		query[key] = values
+	}
+
+	link := Link{Platform: Platform(u.String())}
+	segments := strings.FieldsFunc(u.EscapedPath(), func(r rune) bool { return r == '/' })
+
+	switch link.Platform {
+	case PlatformYouTube:
+		if id := youTubeVideoID(host, segments, query); id != "" {
+			link.ExternalID = id
+			link.URL = "https://www.youtube.com/watch?v=" + id
+			return link, nil
+		}
+	case PlatformSpotify:
+		// open.spotify.com/intl-de/track/{id}
+		if len(segments) > 0 && strings.HasPrefix(segments[0], "intl-") {
+			segments = segments[1:]
+		}
+		if len(segments) == 2 && spotifyID.MatchString(segments[1]) {
+			link.ExternalID = segments[0] + ":" + segments[1]
+			link.URL = "https://open.spotify.com/" + segments[0] + "/" + segments[1]
+			return link, nil
+		}
+	case PlatformApple:
+		// music.apple.com/us/album/{name}/{album id}?i={track id}
+		// music.apple.com/us/song/{name}/{track id}
+		if i := query.Get("i"); numericID.MatchString(i) {
+			link.ExternalID = i
+			query = url.Values{"i": {i}}
+		} else if len(segments) > 0 && numericID.MatchString(segments[len(segments)-1]) {
+			link.ExternalID = segments[len(segments)-1]
+			query = url.Values{}
+		}
+	case PlatformYandex:
+		// music.yandex.ru/album/{album id}/track/{track id}
+		if len(segments) >= 2 && segments[len(segments)-2] == "track" && numericID.MatchString(segments[len(segments)-1]) {
+			link.ExternalID = segments[len(segments)-1]
+			query = url.Values{}
+		}
+	case PlatformSoundCloud:
+		query = url.Values{}
+	}
+
+	if link.Platform != PlatformOther {
+		u.Scheme = "https"
+		port = ""
+		host = strings.TrimPrefix(strings.TrimPrefix(host, "www."), "m.")
+	}
+	u.Host = host
+	if port != "" {
+		u.Host += ":" + port
+	}
+	u.User = nil
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.RawQuery = query.Encode()
+	if len(u.Path) > 1 {
+		u.Path = strings.TrimSuffix(u.Path, "/")
+		u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+	} else {
+		u.Path = "/"
+		u.RawPath = ""
+	}
+
+	link.URL = u.String()
+	return link, nil
+}
+
+// youTubeVideoID
+// extract video id from youtu.be/{id}, /watch?v={id},
+// /embed/{id}, /shorts/{id}, /live/{id} and /v/{id} urls
+func youTubeVideoID(host string, segments []string, query url.Values) string {
+	id := ""
+	switch {
+	case host == "youtu.be" || strings.HasSuffix(host, ".youtu.be"):
+		if len(segments) > 0 {
+			id = segments[0]
+		}
+	case len(segments) == 1 && segments[0] == "watch":
+		id = query.Get("v")
+	case len(segments) == 2:
+		switch segments[0] {
+		case "embed", "shorts", "live", "v":
+			id = segments[1]
+		}
+	}
+	if !youTubeID.MatchString(id) {
+		return ""
+	}
+	return id
+}
@@ -0,0 +1,97 @@
+package links
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPlatform(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected string
+	}{
+		{"https://youtu.be/dQw4w9WgXcQ", PlatformYouTube},
+		{"https://www.youtube.com/watch?v=dQw4w9WgXcQ", PlatformYouTube},
+		{"http://m.youtube.com/watch?v=dQw4w9WgXcQ", PlatformYouTube},
+		{"https://music.youtube.com/watch?v=dQw4w9WgXcQ", PlatformYouTube},
+		{"https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC", PlatformSpotify},
+		{"https://music.apple.com/us/album/1", PlatformApple},
+		{"https://music.yandex.ru/album/1/track/2", PlatformYandex},
+		{"https://SoundCloud.com/artist/song", PlatformSoundCloud},
+		{"https://notyoutube.com/watch", PlatformOther},
+		{"https://apple.com/music", PlatformOther},
+		{"not a url %%", PlatformOther},
+		{"", PlatformOther},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.input, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, Platform(testCase.input))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected Link
+	}{
+		{
+			input:    "https://youtu.be/dQw4w9WgXcQ",
+			expected: Link{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", Platform: PlatformYouTube, ExternalID: "dQw4w9WgXcQ"},
+		},
+		{
+			input:    "https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=1",
+			expected: Link{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", Platform: PlatformYouTube, ExternalID: "dQw4w9WgXcQ"},
+		},
+		{
+			input:    "HTTP://m.youtube.com/watch?feature=share&v=dQw4w9WgXcQ#comments",
+			expected: Link{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", Platform: PlatformYouTube, ExternalID: "dQw4w9WgXcQ"},
+		},
+		{
+			input:    "https://www.youtube.com/shorts/dQw4w9WgXcQ",
+			expected: Link{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", Platform: PlatformYouTube, ExternalID: "dQw4w9WgXcQ"},
+		},
+		{
+			input:    "https://www.youtube.com/@RickAstleyYT/",
+			expected: Link{URL: "https://youtube.com/@RickAstleyYT", Platform: PlatformYouTube},
+		},
+		{
+			input:    "https://open.spotify.com/intl-de/track/4uLU6hMCjMI75M1A2tKUQC?si=abc",
+			expected: Link{URL: "https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC", Platform: PlatformSpotify, ExternalID: "track:4uLU6hMCjMI75M1A2tKUQC"},
+		},
+		{
+			input:    "https://music.apple.com/us/album/never-gonna-give-you-up/1558533900?i=1558534271&l=en",
+			expected: Link{URL: "https://music.apple.com/us/album/never-gonna-give-you-up/1558533900?i=1558534271", Platform: PlatformApple, ExternalID: "1558534271"},
+		},
+		{
+			input:    "https://music.yandex.ru/album/10030/track/38634572?utm_source=desktop",
+			expected: Link{URL: "https://music.yandex.ru/album/10030/track/38634572", Platform: PlatformYandex, ExternalID: "38634572"},
+		},
+		{
+			input:    "https://m.soundcloud.com/rick-astley-official/never-gonna-give-you-up?in=playlist",
+			expected: Link{URL: "https://soundcloud.com/rick-astley-official/never-gonna-give-you-up", Platform: PlatformSoundCloud},
+		},
+		{
+			input:    " http://Example.com:80?b=2&a=1&utm_source=x ",
+			expected: Link{URL: "http://example.com/?a=1&b=2", Platform: PlatformOther},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.input, func(t *testing.T) {
+			link, err := Parse(testCase.input)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, link)
+		})
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	for _, input := range []string{"", "link1", "youtu.be/dQw4w9WgXcQ", "ftp://example.com/song.mp3", "javascript:alert(1)", "https://"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := Parse(input)
+			assert.ErrorIs(t, err, ErrInvalidURL)
+		})
+	}
+}
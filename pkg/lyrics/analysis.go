@@ -0,0 +1,184 @@
+package lyrics
+
+import (
+	"bufio"
+	"embed"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Languages of words, detected by script
+const (
+	LangEnglish = "en"
+	LangRussian = "ru"
+)
+
+//go:embed stopwords/*.txt
+var stopwordFiles embed.FS
+
+// stopwords
+// stop words by language, loaded from embedded lists
+var stopwords = map[string]map[string]bool{
+	LangEnglish: loadStopwords("stopwords/en.txt"),
+	LangRussian: loadStopwords("stopwords/ru.txt"),
+}
+
+// loadStopwords
+// read word per line list, blank lines and # comments are skipped
+func loadStopwords(name string) map[string]bool {
+	f, err := stopwordFiles.Open(name)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	words := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word != "" && !strings.HasPrefix(word, "#") {
+			words[word] = true
+		}
+	}
+	return words
+}
+
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// Analysis
+// text statistics of song lyrics. UniqueRatio is share of distinct
+// words, RepetitionScore is share of lines repeating an earlier line
+type Analysis struct {
+	Language        string
+	Verses          int
+	Lines           int
+	Words           int
+	UniqueWords     int
+	UniqueRatio     float64
+	RepetitionScore float64
+	TopWords        []WordCount
+}
+
+// Analyze
+// compute statistics of verses, top most frequent words
+// are returned with stop words of their language removed
+func Analyze(verses []string, top int) Analysis {
+	a := Analysis{TopWords: make([]WordCount, 0, top)}
+
+	counts := make(map[string]int)
+	seenLines := make(map[string]bool)
+	scripts := make(map[string]int)
+	repeated := 0
+	for _, verse := range verses {
+		verse = Normalize(verse)
+		if verse == "" {
+			continue
+		}
+		a.Verses++
+
+		for _, line := range strings.Split(verse, "\n") {
+			words := Words(line)
+			if len(words) == 0 {
+				continue
+			}
+			a.Lines++
+
+			// lines differing only in case and punctuation are repeats
+			key := strings.Join(words, " ")
+			if seenLines[key] {
+				repeated++
+			}
+			seenLines[key] = true
+
+			for _, word := range words {
+				counts[word]++
+				scripts[wordLanguage(word)]++
+			}
+			a.Words += len(words)
+		}
+	}
+
+	if scripts[LangRussian] > scripts[LangEnglish] {
+		a.Language = LangRussian
+	} else if scripts[LangEnglish] > 0 {
+		a.Language = LangEnglish
+	}
+
+	a.UniqueWords = len(counts)
+	if a.Words > 0 {
+		a.UniqueRatio = round(float64(a.UniqueWords) / float64(a.Words))
+	}
+	if a.Lines > 0 {
+		a.RepetitionScore = round(float64(repeated) / float64(a.Lines))
+	}
+
+	for word, count := range counts {
+		if !stopwords[wordLanguage(word)][word] {
+			a.TopWords = append(a.TopWords, WordCount{Word: word, Count: count})
+		}
+	}
+	sort.Slice(a.TopWords, func(i, j int) bool {
+		if a.TopWords[i].Count != a.TopWords[j].Count {
+			return a.TopWords[i].Count > a.TopWords[j].Count
+		}
+		return a.TopWords[i].Word < a.TopWords[j].Word
+	})
+	if len(a.TopWords) > top {
+		a.TopWords = a.TopWords[:top]
+	}
+	return a
+}
+
+// Words
+// split text into lower case words, apostrophes inside
+// words are kept, ё is replaced by е
+func Words(text string) []string {
+	words := make([]string, 0)
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, strings.Trim(word.String(), "'"))
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if r == 'ё' {
+				r = 'е'
+			}
+			word.WriteRune(r)
+		case (r == '\'' || r == '’') && word.Len() > 0:
+			word.WriteRune('\'')
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words
+}
+
+// wordLanguage
+// return language of word by script of its first letter
+func wordLanguage(word string) string {
+	for _, r := range word {
+		if unicode.Is(unicode.Cyrillic, r) {
+			return LangRussian
+		}
+		if unicode.Is(unicode.Latin, r) {
+			return LangEnglish
+		}
+	}
+	return ""
+}
+
+// round
+// round to hundredths
+func round(x float64) float64 {
+	return math.Round(x*100) / 100
+}
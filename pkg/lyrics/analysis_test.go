@@ -0,0 +1,107 @@
+package lyrics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	testTable := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "punctuation_and_case",
+			input:    "Never gonna give, you UP!",
+			expected: []string{"never", "gonna", "give", "you", "up"},
+		},
+		{
+			name:     "apostrophes",
+			input:    "Don’t stop 'til rock'n'roll'",
+			expected: []string{"don't", "stop", "til", "rock'n'roll"},
+		},
+		{
+			name:     "cyrillic",
+			input:    "Всё идёт по плану",
+			expected: []string{"все", "идет", "по", "плану"},
+		},
+		{
+			name:     "empty",
+			input:    " - ... ",
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, Words(testCase.input))
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	testTable := []struct {
+		name     string
+		verses   []string
+		top      int
+		expected Analysis
+	}{
+		{
+			name: "english_with_chorus",
+			verses: []string{
+				"Never gonna give you up\nNever gonna let you down",
+				"We know the game\n\n",
+				"Never gonna give you up!\nnever gonna let you down",
+			},
+			top: 3,
+			expected: Analysis{
+				Language:        LangEnglish,
+				Verses:          3,
+				Lines:           5,
+				Words:           24,
+				UniqueWords:     11,
+				UniqueRatio:     0.46,
+				RepetitionScore: 0.4,
+				TopWords: []WordCount{
+					{Word: "gonna", Count: 4},
+					{Word: "never", Count: 4},
+					{Word: "give", Count: 2},
+				},
+			},
+		},
+		{
+			name:   "russian",
+			verses: []string{"Я иду по улице\nи пою о тебе"},
+			top:    10,
+			expected: Analysis{
+				Language:        LangRussian,
+				Verses:          1,
+				Lines:           2,
+				Words:           8,
+				UniqueWords:     8,
+				UniqueRatio:     1,
+				RepetitionScore: 0,
+				TopWords: []WordCount{
+					{Word: "иду", Count: 1},
+					{Word: "пою", Count: 1},
+					{Word: "улице", Count: 1},
+				},
+			},
+		},
+		{
+			name:   "empty",
+			verses: []string{"", " \n "},
+			top:    10,
+			expected: Analysis{
+				TopWords: []WordCount{},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, Analyze(testCase.verses, testCase.top))
+		})
+	}
+}
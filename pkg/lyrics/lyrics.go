@@ -0,0 +1,41 @@
+package lyrics
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// blank line, possibly with spaces, separates verses
+	verseBreak = regexp.MustCompile(`\n[ \t\f\v]*\n`)
+	// horizontal whitespace run inside a line
+	spaceRun = regexp.MustCompile(`[ \t\f\v\x{00A0}]+`)
+)
+
+// Normalize
+// convert line endings to \n, collapse horizontal whitespace,
+// trim lines and remove leading and trailing blank lines
+func Normalize(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		lines[i] = strings.TrimSpace(spaceRun.ReplaceAllString(lines[i], " "))
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// SplitVerses
+// split normalized text into verses by blank lines, empty verses are dropped
+func SplitVerses(text string) []string {
+	parts := verseBreak.Split(Normalize(text), -1)
+	verses := make([]string, 0, len(parts))
+	for i := 0; i < len(parts); i++ {
+		verse := strings.Trim(parts[i], "\n")
+		if verse != "" {
+			verses = append(verses, verse)
+		}
+	}
+	return verses
+}
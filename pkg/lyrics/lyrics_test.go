@@ -0,0 +1,46 @@
+package lyrics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSplitVerses(t *testing.T) {
+	testTable := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "unix",
+			input:    "line1\nline2\n\nline3",
+			expected: []string{"line1\nline2", "line3"},
+		},
+		{
+			name:     "windows",
+			input:    "line1\r\nline2\r\n\r\nline3\r\n",
+			expected: []string{"line1\nline2", "line3"},
+		},
+		{
+			name:     "old_mac",
+			input:    "line1\r\rline2",
+			expected: []string{"line1", "line2"},
+		},
+		{
+			name:     "whitespace_and_empty_verses",
+			input:    "\n\n  line1   with \t spaces  \n \t \n\n\n\nline2\n\n   \n",
+			expected: []string{"line1 with spaces", "line2"},
+		},
+		{
+			name:     "empty",
+			input:    " \r\n \n",
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, SplitVerses(testCase.input))
+		})
+	}
+}
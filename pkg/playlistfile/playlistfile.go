@@ -0,0 +1,108 @@
+// Package playlistfile writes playlists in formats media players import:
+// extended M3U and XSPF
+package playlistfile
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Content types of formats
+const (
+	ContentTypeM3U  = "audio/x-mpegurl"
+	ContentTypeXSPF = "application/xspf+xml"
+)
+
+type Track struct {
+	Title    string
+	Creator  string
+	Duration time.Duration
+	Location string
+}
+
+type Playlist struct {
+	Title  string
+	Tracks []Track
+}
+
+// WriteM3U
+// write playlist as extended M3U in UTF-8 (M3U8). Tracks without
+// location are skipped as M3U entry is its location
+func WriteM3U(w io.Writer, p *Playlist) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("#EXTM3U\n")
+	if p.Title != "" {
+		bw.WriteString("#PLAYLIST:" + singleLine(p.Title) + "\n")
+	}
+
+	for _, t := range p.Tracks {
+		if t.Location == "" {
+			continue
+		}
+		seconds := -1
+		if t.Duration > 0 {
+			seconds = int(t.Duration.Round(time.Second) / time.Second)
+		}
+
+		title := singleLine(t.Title)
+		if t.Creator != "" {
+			title = singleLine(t.Creator) + " - " + title
+		}
+
+		bw.WriteString("#EXTINF:" + strconv.Itoa(seconds) + "," + title + "\n")
+		bw.WriteString(singleLine(t.Location) + "\n")
+	}
+	return bw.Flush()
+}
+
+type xspfPlaylist struct {
+	XMLName xml.Name `xml:"http://xspf.org/ns/0/ playlist"`
+	Version string   `xml:"version,attr"`
+	Title   string   `xml:"title,omitempty"`
+	// trackList element is required even if empty
+	TrackList struct {
+		Tracks []xspfTrack `xml:"track"`
+	} `xml:"trackList"`
+}
+
+type xspfTrack struct {
+	Location string `xml:"location,omitempty"`
+	Creator  string `xml:"creator,omitempty"`
+	Title    string `xml:"title,omitempty"`
+	Duration int64  `xml:"duration,omitempty"`
+}
+
+// WriteXSPF
+// write playlist as XSPF version 1, duration is in milliseconds
+func WriteXSPF(w io.Writer, p *Playlist) error {
+	playlist := xspfPlaylist{Version: "1", Title: p.Title}
+	for _, t := range p.Tracks {
+		playlist.TrackList.Tracks = append(playlist.TrackList.Tracks, xspfTrack{
+			Location: t.Location,
+			Creator:  t.Creator,
+			Title:    t.Title,
+			Duration: t.Duration.Milliseconds(),
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(playlist); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// singleLine
+// replace line breaks which would start new M3U line
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
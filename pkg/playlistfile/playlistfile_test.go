@@ -0,0 +1,66 @@
+package playlistfile
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var testPlaylist = &Playlist{
+	Title: "Road\ntrip",
+	Tracks: []Track{
+		{Title: "Supermassive Black Hole", Creator: "Muse", Duration: 212600 * time.Millisecond, Location: "https://youtu.be/Xsp3_a-PMTw"},
+		{Title: "No link", Creator: "Nobody"},
+		{Title: "Kino & <friends>", Location: "https://example.com/a?b=1&c=2"},
+	},
+}
+
+func TestWriteM3U(t *testing.T) {
+	buf := bytes.Buffer{}
+	err := WriteM3U(&buf, testPlaylist)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "#EXTM3U\n"+
+		"#PLAYLIST:Road trip\n"+
+		"#EXTINF:213,Muse - Supermassive Black Hole\n"+
+		"https://youtu.be/Xsp3_a-PMTw\n"+
+		"#EXTINF:-1,Kino & <friends>\n"+
+		"https://example.com/a?b=1&c=2\n", buf.String())
+}
+
+func TestWriteXSPF(t *testing.T) {
+	buf := bytes.Buffer{}
+	err := WriteXSPF(&buf, testPlaylist)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<playlist xmlns="http://xspf.org/ns/0/" version="1">
+  <title>Road&#xA;trip</title>
+  <trackList>
+    <track>
+      <location>https://youtu.be/Xsp3_a-PMTw</location>
+      <creator>Muse</creator>
+      <title>Supermassive Black Hole</title>
+      <duration>212600</duration>
+    </track>
+    <track>
+      <creator>Nobody</creator>
+      <title>No link</title>
+    </track>
+    <track>
+      <location>https://example.com/a?b=1&amp;c=2</location>
+      <title>Kino &amp; &lt;friends&gt;</title>
+    </track>
+  </trackList>
+</playlist>
+`, buf.String())
+}
+
+func TestWriteXSPF_empty(t *testing.T) {
+	buf := bytes.Buffer{}
+	err := WriteXSPF(&buf, &Playlist{})
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "<trackList></trackList>")
+}
@@ -0,0 +1,168 @@
+package profanity
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"eMobile/pkg/lyrics"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var ErrUnknownLanguage = errors.New("unknown profanity language")
+
+//go:embed lists/*.txt
+var listFiles embed.FS
+
+// list
+// word patterns of one language
+type list struct {
+	exact    map[string]bool
+	prefix   []string
+	suffix   []string
+	contains []string
+}
+
+// Classifier
+// offline classifier of explicit text by per-language word lists
+type Classifier struct {
+	lists       []*list
+	fingerprint string
+}
+
+// New
+// return classifier of languages. List of language is read from
+// <language>.txt in dir if it exists, otherwise embedded list is used.
+// Empty dir uses embedded lists only
+func New(languages []string, dir string) (*Classifier, error) {
+	c := &Classifier{}
+	sums := make([]string, 0, len(languages))
+	for _, lang := range languages {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" {
+			continue
+		}
+
+		f, err := openList(lang, dir)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.New()
+		l, err := readList(io.TeeReader(f, hash))
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		c.lists = append(c.lists, l)
+		sums = append(sums, lang+":"+hex.EncodeToString(hash.Sum(nil)))
+	}
+
+	// order of languages does not change classification
+	sort.Strings(sums)
+	sum := sha256.Sum256([]byte(strings.Join(sums, "\n")))
+	c.fingerprint = hex.EncodeToString(sum[:])
+	return c, nil
+}
+
+// Fingerprint
+// return hash of languages and their lists, it changes
+// whenever classification of some text may change
+func (c *Classifier) Fingerprint() string {
+	return c.fingerprint
+}
+
+// openList
+// open list of language from dir, falling back to embedded one
+func openList(lang, dir string) (io.ReadCloser, error) {
+	name := lang + ".txt"
+	if dir != "" {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	f, err := listFiles.Open("lists/" + name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, lang)
+	}
+	return f, err
+}
+
+// readList
+// read pattern per line list, blank lines and # comments are skipped.
+// Patterns are normalized like words of text
+func readList(r io.Reader) (*list, error) {
+	l := &list{exact: make(map[string]bool)}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		leading, trailing := strings.HasPrefix(line, "*"), strings.HasSuffix(line, "*")
+		word := strings.Join(lyrics.Words(strings.Trim(line, "*")), "")
+		if word == "" {
+			continue
+		}
+		switch {
+		case leading && trailing:
+			l.contains = append(l.contains, word)
+		case leading:
+			l.suffix = append(l.suffix, word)
+		case trailing:
+			l.prefix = append(l.prefix, word)
+		default:
+			l.exact[word] = true
+		}
+	}
+	return l, scanner.Err()
+}
+
+// match
+// return true if word matches any pattern of list
+func (l *list) match(word string) bool {
+	if l.exact[word] {
+		return true
+	}
+	for _, p := range l.prefix {
+		if strings.HasPrefix(word, p) {
+			return true
+		}
+	}
+	for _, p := range l.suffix {
+		if strings.HasSuffix(word, p) {
+			return true
+		}
+	}
+	for _, p := range l.contains {
+		if strings.Contains(word, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExplicit
+// return true if any word of text matches lists
+func (c *Classifier) IsExplicit(text string) bool {
+	for _, word := range lyrics.Words(text) {
+		for _, l := range c.lists {
+			if l.match(word) {
+				return true
+			}
+		}
+	}
+	return false
+}
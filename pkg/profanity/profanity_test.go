@@ -0,0 +1,72 @@
+package profanity
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClassifier_IsExplicit(t *testing.T) {
+	c, err := New([]string{"en", "ru"}, "")
+	assert.NoError(t, err)
+
+	testTable := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{name: "clean_english", input: "Never gonna give you up\nnever gonna let you down", expected: false},
+		{name: "clean_similar_words", input: "Dickens wrote of Scunthorpe pussycat", expected: false},
+		{name: "contains", input: "What the MOTHERFUCKING hell", expected: true},
+		{name: "prefix", input: "You bitches!", expected: true},
+		{name: "exact", input: "he is a dick", expected: true},
+		{name: "clean_russian", input: "Под небом голубым есть город золотой, хлеба", expected: false},
+		{name: "russian_with_yo", input: "Ёбаный стыд", expected: true},
+		{name: "russian_hard_sign", input: "подъебка", expected: true},
+		{name: "empty", input: "", expected: false},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, c.IsExplicit(testCase.input))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "en.txt"), []byte("# custom\nheck*\n"), 0o644)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(dir, "de.txt"), []byte("scheisse\n"), 0o644)
+	assert.NoError(t, err)
+
+	c, err := New([]string{"en", "de", " ru "}, dir)
+	assert.NoError(t, err)
+	assert.True(t, c.IsExplicit("what the heckin"))
+	assert.False(t, c.IsExplicit("fuck"), "dir list replaces embedded one")
+	assert.True(t, c.IsExplicit("Scheisse"))
+	assert.True(t, c.IsExplicit("сука"))
+
+	_, err = New([]string{"xx"}, dir)
+	assert.True(t, errors.Is(err, ErrUnknownLanguage))
+}
+
+func TestClassifier_Fingerprint(t *testing.T) {
+	dir := t.TempDir()
+	enRu, err := New([]string{"en", "ru"}, dir)
+	assert.NoError(t, err)
+	ruEn, err := New([]string{"ru", "en"}, dir)
+	assert.NoError(t, err)
+	en, err := New([]string{"en"}, dir)
+	assert.NoError(t, err)
+	assert.Equal(t, enRu.Fingerprint(), ruEn.Fingerprint())
+	assert.NotEqual(t, enRu.Fingerprint(), en.Fingerprint())
+
+	err = os.WriteFile(filepath.Join(dir, "en.txt"), []byte("heck*\n"), 0o644)
+	assert.NoError(t, err)
+	custom, err := New([]string{"en"}, dir)
+	assert.NoError(t, err)
+	assert.NotEqual(t, en.Fingerprint(), custom.Fingerprint())
+}
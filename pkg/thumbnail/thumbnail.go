@@ -0,0 +1,106 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+// Image formats accepted by Decode
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+)
+
+// maxPixels
+// larger images are rejected before decoding to bound memory usage,
+// 4096x4096 decodes to 64MB of RGBA
+const maxPixels = 4096 * 4096
+
+var (
+	ErrUnsupportedFormat = errors.New("image must be jpeg or png")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+)
+
+// Decode
+// decode jpeg or png image, return image and its format
+func Decode(data []byte) (image.Image, string, error) {
+	conf, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != FormatJPEG && format != FormatPNG) {
+		return nil, "", ErrUnsupportedFormat
+	}
+	if conf.Width <= 0 || conf.Height <= 0 || conf.Width*conf.Height > maxPixels {
+		return nil, "", ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	return img, format, nil
+}
+
+// Resize
+// scale image returned by Flatten down so that its longest side is size
+// pixels, aspect ratio is kept. Smaller images are returned as is, not
+// upscaled. Each target pixel is average of source pixels it covers
+func Resize(src *image.RGBA, size int) *image.RGBA {
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+
+	dw, dh := w, h
+	if w >= h && w > size {
+		dw, dh = size, max(1, h*size/w)
+	} else if h > w && h > size {
+		dw, dh = max(1, w*size/h), size
+	}
+	if dw == w && dh == h {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := y*h/dh, max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := x*w/dw, max((x+1)*w/dw, x*w/dw+1)
+
+			var r, g, b, n int
+			for sy := sy0; sy < sy1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := sx0; sx < sx1; sx++ {
+					r += int(row[sx*4])
+					g += int(row[sx*4+1])
+					b += int(row[sx*4+2])
+					n++
+				}
+			}
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// Flatten
+// convert image to opaque RGBA with origin at zero point, transparent
+// pixels are blended over white. Done once per image, it is full size copy
+func Flatten(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Rect, img, b.Min, draw.Over)
+	return dst
+}
+
+// EncodeJPEG
+// write image as jpeg of quality used for thumbnails
+func EncodeJPEG(w io.Writer, img image.Image) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: 85})
+}
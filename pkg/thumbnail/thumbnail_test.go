@@ -0,0 +1,55 @@
+package thumbnail
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	buf := bytes.Buffer{}
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 4, 2))))
+
+	img, format, err := Decode(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, FormatPNG, format)
+	assert.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+
+	_, _, err = Decode([]byte("GIF89a not an image"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	// IHDR patched to 4097x4096, rejected from header alone
+	large := buf.Bytes()
+	binary.BigEndian.PutUint32(large[16:], 4097)
+	binary.BigEndian.PutUint32(large[20:], 4096)
+	binary.BigEndian.PutUint32(large[29:], crc32.ChecksumIEEE(large[12:29]))
+	_, _, err = Decode(large)
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestResize(t *testing.T) {
+	// left half black, right half transparent
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 200; x++ {
+			img.Set(x, y, color.Black)
+		}
+	}
+
+	flat := Flatten(img)
+	thumb := Resize(flat, 64)
+	assert.Equal(t, image.Rect(0, 0, 64, 16), thumb.Bounds())
+	assert.Equal(t, color.RGBA{A: 0xff}, thumb.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, thumb.RGBAAt(63, 15))
+
+	small := Resize(flat, 640)
+	assert.Equal(t, image.Rect(0, 0, 400, 100), small.Bounds())
+
+	tall := Resize(Flatten(image.NewRGBA(image.Rect(0, 0, 10, 1000))), 256)
+	assert.Equal(t, image.Rect(0, 0, 2, 256), tall.Bounds())
+}
@@ -0,0 +1,89 @@
+package trackmeta
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Musical key modes
+const (
+	ModeMajor = "major"
+	ModeMinor = "minor"
+)
+
+var (
+	ErrInvalidISRC = errors.New("must be 12 characters: country, registrant, year and designation code, example: GB-AYE-87-00123")
+	ErrInvalidKey  = errors.New("must be note with optional accidental and mode, example: C# minor")
+)
+
+// isrcFormat
+// country code (2 letters), registrant code (3 alphanumerics),
+// year of reference (2 digits), designation code (5 digits)
+var isrcFormat = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`)
+
+// ISRC
+// validate International Standard Recording Code and return its compact
+// upper case form. Hyphens, spaces and "ISRC" prefix are dropped.
+// ISRC has no check digit, so format and non-zero designation code are checked.
+// "gb-aye-87-00123" -> "GBAYE8700123"
+func ISRC(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	code = strings.TrimSpace(strings.TrimPrefix(code, "ISRC"))
+	code = strings.TrimPrefix(code, ":")
+	code = strings.Map(func(r rune) rune {
+		if r == '-' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, code)
+
+	if !isrcFormat.MatchString(code) || strings.HasSuffix(code, "00000") {
+		return "", ErrInvalidISRC
+	}
+	return code, nil
+}
+
+// Key
+// parse musical key and return it in form "<note> <mode>".
+// Accepts "C#m", "c# min", "Db", "D♭ major", "Am", "A minor";
+// mode is major if omitted, "M" is major and "m" is minor
+func Key(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	key = strings.NewReplacer("♯", "#", "♭", "b").Replace(key)
+	if key == "" {
+		return "", ErrInvalidKey
+	}
+
+	note, size := utf8.DecodeRuneInString(key)
+	note = unicode.ToUpper(note)
+	if note < 'A' || note > 'G' {
+		return "", ErrInvalidKey
+	}
+	result := string(note)
+	rest := key[size:]
+	if strings.HasPrefix(rest, "#") || strings.HasPrefix(rest, "b") {
+		result += rest[:1]
+		rest = rest[1:]
+	}
+
+	mode := ""
+	switch rest = strings.TrimSpace(rest); {
+	case rest == "M":
+		mode = ModeMajor
+	case rest == "m":
+		mode = ModeMinor
+	default:
+		switch strings.ToLower(rest) {
+		case "", "maj", "major":
+			mode = ModeMajor
+		case "min", "minor":
+			mode = ModeMinor
+		default:
+			return "", ErrInvalidKey
+		}
+	}
+	return result + " " + mode, nil
+}
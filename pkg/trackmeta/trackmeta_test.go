@@ -0,0 +1,62 @@
+package trackmeta
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestISRC(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"GBAYE8700123", "GBAYE8700123", nil},
+		{"gb-aye-87-00123", "GBAYE8700123", nil},
+		{"ISRC: US-S1Z-99-00001", "USS1Z9900001", nil},
+		{" RU A01 23 45678 ", "RUA012345678", nil},
+		{"GBAYE870012", "", ErrInvalidISRC},
+		{"GBAYE87001234", "", ErrInvalidISRC},
+		{"1BAYE8700123", "", ErrInvalidISRC},
+		{"GBAYE8A00123", "", ErrInvalidISRC},
+		{"GB-AYE-87-00000", "", ErrInvalidISRC},
+		{"", "", ErrInvalidISRC},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.input, func(t *testing.T) {
+			code, err := ISRC(testCase.input)
+			assert.Equal(t, testCase.expected, code)
+			assert.Equal(t, testCase.err, err)
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	testTable := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"C", "C major", nil},
+		{"c#m", "C# minor", nil},
+		{"Db", "Db major", nil},
+		{"D♭ major", "Db major", nil},
+		{"F♯ min", "F# minor", nil},
+		{"bbm", "Bb minor", nil},
+		{"A minor", "A minor", nil},
+		{"AM", "A major", nil},
+		{"Am", "A minor", nil},
+		{"H", "", ErrInvalidKey},
+		{"C dorian", "", ErrInvalidKey},
+		{"", "", ErrInvalidKey},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.input, func(t *testing.T) {
+			key, err := Key(testCase.input)
+			assert.Equal(t, testCase.expected, key)
+			assert.Equal(t, testCase.err, err)
+		})
+	}
+}